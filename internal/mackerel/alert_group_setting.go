@@ -15,19 +15,40 @@ type AlertGroupSettingModel struct {
 	RoleScopes           []string     `tfsdk:"role_scopes"`
 	MonitorScopes        []string     `tfsdk:"monitor_scopes"`
 	NotificationInterval types.Int64  `tfsdk:"notification_interval"`
+	// Stored in the footer of the memo.
+	Labels map[string]string `tfsdk:"labels"`
 }
 
-func ReadAlertGroupSetting(_ context.Context, client *Client, id string) (AlertGroupSettingModel, error) {
-	mag, err := client.GetAlertGroupSetting(id)
+func ReadAlertGroupSetting(ctx context.Context, client *Client, id string) (AlertGroupSettingModel, error) {
+	mag, err := client.GetAlertGroupSettingContext(ctx, id)
+	if err != nil {
+		return AlertGroupSettingModel{}, wrapNotFound(err)
+	}
+	model := newAlertGroupSetting(*mag)
+	model.Name = client.trimNamePrefix(model.Name)
+	return model, nil
+}
+
+// Finds the only alert group setting which has all of the labels.
+func FindAlertGroupSettingByLabels(ctx context.Context, client *Client, labels map[string]string) (AlertGroupSettingModel, error) {
+	mags, err := client.FindAlertGroupSettingsContext(ctx)
 	if err != nil {
 		return AlertGroupSettingModel{}, err
 	}
-	return newAlertGroupSetting(*mag), nil
+	models := make([]AlertGroupSettingModel, 0, len(mags))
+	for _, mag := range mags {
+		model := newAlertGroupSetting(*mag)
+		model.Name = client.trimNamePrefix(model.Name)
+		models = append(models, model)
+	}
+	return findByLabels("alert group setting", models, func(ag AlertGroupSettingModel) map[string]string { return ag.Labels }, labels)
 }
 
-func (ag *AlertGroupSettingModel) Create(_ context.Context, client *Client) error {
+func (ag *AlertGroupSettingModel) Create(ctx context.Context, client *Client) error {
 	param := ag.mackerelAlertGroupSetting()
-	mag, err := client.CreateAlertGroupSetting(&param)
+	param.Name = client.prefixName(ag.Name).ValueString()
+	param.Memo = joinMemo(param.Memo, client.managedMarker, ag.Labels)
+	mag, err := client.CreateAlertGroupSettingContext(ctx, &param)
 	if err != nil {
 		return err
 	}
@@ -46,23 +67,25 @@ func (ag *AlertGroupSettingModel) Read(ctx context.Context, client *Client) erro
 	return nil
 }
 
-func (ag AlertGroupSettingModel) Update(_ context.Context, client *Client) error {
+func (ag AlertGroupSettingModel) Update(ctx context.Context, client *Client) error {
 	param := ag.mackerelAlertGroupSetting()
-	if _, err := client.UpdateAlertGroupSetting(ag.ID.ValueString(), &param); err != nil {
+	param.Name = client.prefixName(ag.Name).ValueString()
+	param.Memo = joinMemo(param.Memo, client.managedMarker, ag.Labels)
+	if _, err := client.UpdateAlertGroupSettingContext(ctx, ag.ID.ValueString(), &param); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (ag AlertGroupSettingModel) Delete(_ context.Context, client *Client) error {
-	if _, err := client.DeleteAlertGroupSetting(ag.ID.ValueString()); err != nil {
+func (ag AlertGroupSettingModel) Delete(ctx context.Context, client *Client) error {
+	if _, err := client.DeleteAlertGroupSettingContext(ctx, ag.ID.ValueString()); err != nil {
 		return err
 	}
 	return nil
 }
 
 func newAlertGroupSetting(ag mackerel.AlertGroupSetting) AlertGroupSettingModel {
-	return AlertGroupSettingModel{
+	model := AlertGroupSettingModel{
 		ID:                   types.StringValue(ag.ID),
 		Name:                 types.StringValue(ag.Name),
 		Memo:                 types.StringValue(ag.Memo),
@@ -71,6 +94,8 @@ func newAlertGroupSetting(ag mackerel.AlertGroupSetting) AlertGroupSettingModel
 		MonitorScopes:        nilAsEmptySlice(ag.MonitorScopes),
 		NotificationInterval: types.Int64Value(int64(ag.NotificationInterval)),
 	}
+	model.Memo, model.Labels = splitMemoValue(model.Memo)
+	return model
 }
 
 func (ag AlertGroupSettingModel) mackerelAlertGroupSetting() mackerel.AlertGroupSetting {
@@ -0,0 +1,334 @@
+package mackerel
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/typeutil"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+type AlertsModel struct {
+	ID           types.String        `tfsdk:"id"`
+	WithClosed   types.Bool          `tfsdk:"with_closed"`
+	Statuses     []string            `tfsdk:"statuses"`
+	MonitorIDs   []string            `tfsdk:"monitor_ids"`
+	MonitorTypes []string            `tfsdk:"monitor_types"`
+	HostIDs      []string            `tfsdk:"host_ids"`
+	Service      types.String        `tfsdk:"service"`
+	RoleFullname types.String        `tfsdk:"role_fullname"`
+	From         typeutil.TimeString `tfsdk:"from"`
+	To           typeutil.TimeString `tfsdk:"to"`
+	Limit        types.Int64         `tfsdk:"limit"`
+	WithLogs     types.Bool          `tfsdk:"with_logs"`
+	IDs          []string            `tfsdk:"ids"`
+	Alerts       []AlertModel        `tfsdk:"alerts"`
+}
+
+type AlertModel struct {
+	ID        types.String    `tfsdk:"id"`
+	Status    types.String    `tfsdk:"status"`
+	MonitorID types.String    `tfsdk:"monitor_id"`
+	Type      types.String    `tfsdk:"type"`
+	HostID    types.String    `tfsdk:"host_id"`
+	Value     types.Float64   `tfsdk:"value"`
+	Message   types.String    `tfsdk:"message"`
+	Reason    types.String    `tfsdk:"reason"`
+	Memo      types.String    `tfsdk:"memo"`
+	OpenedAt  types.Int64     `tfsdk:"opened_at"`
+	ClosedAt  types.Int64     `tfsdk:"closed_at"`
+	Logs      []AlertLogModel `tfsdk:"logs"`
+}
+
+type AlertLogModel struct {
+	ID          types.String  `tfsdk:"id"`
+	CreatedAt   types.Int64   `tfsdk:"created_at"`
+	Status      types.String  `tfsdk:"status"`
+	Trigger     types.String  `tfsdk:"trigger"`
+	MonitorID   types.String  `tfsdk:"monitor_id"`
+	TargetValue types.Float64 `tfsdk:"target_value"`
+}
+
+func AlertStatusValidator() validator.String {
+	return stringvalidator.OneOf("OK", "CRITICAL", "WARNING", "UNKNOWN")
+}
+
+func AlertMonitorTypeValidator() validator.String {
+	return stringvalidator.OneOf(
+		"connectivity",
+		"host",
+		"service",
+		"external",
+		"check",
+		"expression",
+		"anomalyDetection",
+		"query",
+	)
+}
+
+// Reads alerts which match all of the filters in the config, following the pagination.
+func ReadAlerts(ctx context.Context, client *Client, config AlertsModel) (AlertsModel, error) {
+	return readAlertsInner(ctx, client, config)
+}
+
+type alertsFinder interface {
+	FindAlertsContext(context.Context) (*mackerel.AlertsResp, error)
+	FindAlertsByNextIDContext(context.Context, string) (*mackerel.AlertsResp, error)
+	FindWithClosedAlertsContext(context.Context) (*mackerel.AlertsResp, error)
+	FindWithClosedAlertsByNextIDContext(context.Context, string) (*mackerel.AlertsResp, error)
+}
+
+type alertsReader interface {
+	alertsFinder
+	FindHostsContext(context.Context, *mackerel.FindHostsParam) ([]*mackerel.Host, error)
+	FindMonitorsContext(context.Context) ([]mackerel.Monitor, error)
+	FindAlertLogsContext(context.Context, string, *mackerel.FindAlertLogsParam) (*mackerel.FindAlertLogsResp, error)
+}
+
+func readAlertsInner(ctx context.Context, client alertsReader, config AlertsModel) (AlertsModel, error) {
+	data := config
+	data.ID = types.StringValue(alertsID(config))
+
+	match, err := config.alertMatcher(ctx, client)
+	if err != nil {
+		return data, err
+	}
+
+	withClosed := config.WithClosed.ValueBool()
+	from, to := config.From.ValueEpoch(), config.To.ValueEpoch()
+	limit := int(config.Limit.ValueInt64())
+
+	data.IDs = []string{}
+	data.Alerts = []AlertModel{}
+	nextID := ""
+pages:
+	for {
+		resp, err := findAlertsPage(ctx, client, withClosed, nextID)
+		if err != nil {
+			return data, err
+		}
+		for _, a := range resp.Alerts {
+			// Alerts are listed from the newest one, so the rest are all older than the range.
+			if !config.From.IsNull() && a.OpenedAt < from {
+				break pages
+			}
+			if !config.To.IsNull() && a.OpenedAt > to {
+				continue
+			}
+			if !match(a) {
+				continue
+			}
+			data.IDs = append(data.IDs, a.ID)
+			data.Alerts = append(data.Alerts, newAlert(*a))
+			if limit > 0 && len(data.Alerts) >= limit {
+				break pages
+			}
+		}
+		if resp.NextID == "" {
+			break
+		}
+		nextID = resp.NextID
+	}
+
+	if config.WithLogs.ValueBool() {
+		for i, a := range data.Alerts {
+			logs, err := findAlertLogs(ctx, client, a.ID.ValueString())
+			if err != nil {
+				return data, err
+			}
+			data.Alerts[i].Logs = logs
+		}
+	}
+	return data, nil
+}
+
+func findAlertsPage(ctx context.Context, client alertsFinder, withClosed bool, nextID string) (*mackerel.AlertsResp, error) {
+	switch {
+	case withClosed && nextID == "":
+		return client.FindWithClosedAlertsContext(ctx)
+	case withClosed:
+		return client.FindWithClosedAlertsByNextIDContext(ctx, nextID)
+	case nextID == "":
+		return client.FindAlertsContext(ctx)
+	default:
+		return client.FindAlertsByNextIDContext(ctx, nextID)
+	}
+}
+
+// Returns the filter of alerts other than the time range.
+// Alerts of a service are the ones of its hosts and of the monitors which target the service, such as service metric monitors.
+// Alerts of a role are only the ones of its hosts.
+func (m AlertsModel) alertMatcher(ctx context.Context, client alertsReader) (func(*mackerel.Alert) bool, error) {
+	var (
+		targeted          bool
+		hostIDs           []string
+		serviceMonitorIDs []string
+	)
+	service, role, hasRole := strings.Cut(m.RoleFullname.ValueString(), ":")
+	if !m.Service.IsNull() {
+		service = m.Service.ValueString()
+	}
+	if service != "" {
+		targeted = true
+		param := mackerel.FindHostsParam{
+			Service: service,
+			Statuses: []string{
+				mackerel.HostStatusWorking,
+				mackerel.HostStatusStandby,
+				mackerel.HostStatusMaintenance,
+				mackerel.HostStatusPoweroff,
+			},
+		}
+		if hasRole {
+			param.Roles = []string{role}
+		}
+		hosts, err := client.FindHostsContext(ctx, &param)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range hosts {
+			hostIDs = append(hostIDs, h.ID)
+		}
+
+		if !hasRole {
+			monitors, err := client.FindMonitorsContext(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, mon := range monitors {
+				if monitorService(mon) == service {
+					serviceMonitorIDs = append(serviceMonitorIDs, mon.MonitorID())
+				}
+			}
+		}
+	}
+
+	return func(a *mackerel.Alert) bool {
+		if len(m.Statuses) > 0 && !slices.Contains(m.Statuses, a.Status) {
+			return false
+		}
+		if len(m.MonitorIDs) > 0 && !slices.Contains(m.MonitorIDs, a.MonitorID) {
+			return false
+		}
+		if len(m.MonitorTypes) > 0 && !slices.Contains(m.MonitorTypes, a.Type) {
+			return false
+		}
+		if len(m.HostIDs) > 0 && !slices.Contains(m.HostIDs, a.HostID) {
+			return false
+		}
+		if targeted && !slices.Contains(hostIDs, a.HostID) && !slices.Contains(serviceMonitorIDs, a.MonitorID) {
+			return false
+		}
+		return true
+	}, nil
+}
+
+// Returns the service which the monitor targets, or an empty string for the monitors of hosts or of no service.
+func monitorService(m mackerel.Monitor) string {
+	switch m := m.(type) {
+	case *mackerel.MonitorServiceMetric:
+		return m.Service
+	case *mackerel.MonitorExternalHTTP:
+		return m.Service
+	default:
+		return ""
+	}
+}
+
+func findAlertLogs(ctx context.Context, client alertsReader, id string) ([]AlertLogModel, error) {
+	logs := []AlertLogModel{}
+	param := mackerel.FindAlertLogsParam{}
+	for {
+		resp, err := client.FindAlertLogsContext(ctx, id, &param)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range resp.AlertLogs {
+			logs = append(logs, newAlertLog(*l))
+		}
+		if resp.NextID == "" {
+			return logs, nil
+		}
+		param.NextId = &resp.NextID
+	}
+}
+
+// Identifies the data source by the filters, e.g. "service=foo&status=CRITICAL".
+func alertsID(m AlertsModel) string {
+	v := url.Values{}
+	if m.WithClosed.ValueBool() {
+		v.Set("withClosed", "true")
+	}
+	for key, values := range map[string][]string{
+		"status":      m.Statuses,
+		"monitorId":   m.MonitorIDs,
+		"monitorType": m.MonitorTypes,
+		"hostId":      m.HostIDs,
+	} {
+		if len(values) > 0 {
+			values = slices.Clone(values)
+			slices.Sort(values)
+			v[key] = values
+		}
+	}
+	if m.Service.ValueString() != "" {
+		v.Set("service", m.Service.ValueString())
+	}
+	if service, role, ok := strings.Cut(m.RoleFullname.ValueString(), ":"); ok {
+		v.Set("service", service)
+		v.Set("role", role)
+	}
+	if !m.From.IsNull() {
+		v.Set("from", strconv.FormatInt(m.From.ValueEpoch(), 10))
+	}
+	if !m.To.IsNull() {
+		v.Set("to", strconv.FormatInt(m.To.ValueEpoch(), 10))
+	}
+	if m.Limit.ValueInt64() > 0 {
+		v.Set("limit", strconv.FormatInt(m.Limit.ValueInt64(), 10))
+	}
+	if m.WithLogs.ValueBool() {
+		v.Set("withLogs", "true")
+	}
+	if len(v) == 0 {
+		return "open"
+	}
+	return v.Encode()
+}
+
+func newAlert(a mackerel.Alert) AlertModel {
+	closedAt := types.Int64Null()
+	if a.ClosedAt != 0 {
+		closedAt = types.Int64Value(a.ClosedAt)
+	}
+	return AlertModel{
+		ID:        types.StringValue(a.ID),
+		Status:    types.StringValue(a.Status),
+		MonitorID: types.StringValue(a.MonitorID),
+		Type:      types.StringValue(a.Type),
+		HostID:    types.StringValue(a.HostID),
+		Value:     types.Float64Value(a.Value),
+		Message:   types.StringValue(a.Message),
+		Reason:    types.StringValue(a.Reason),
+		Memo:      types.StringValue(a.Memo),
+		OpenedAt:  types.Int64Value(a.OpenedAt),
+		ClosedAt:  closedAt,
+	}
+}
+
+func newAlertLog(l mackerel.AlertLog) AlertLogModel {
+	return AlertLogModel{
+		ID:          types.StringValue(l.ID),
+		CreatedAt:   types.Int64Value(l.CreatedAt),
+		Status:      types.StringValue(l.Status),
+		Trigger:     types.StringValue(l.Trigger),
+		MonitorID:   types.StringPointerValue(l.MonitorID),
+		TargetValue: types.Float64PointerValue(l.TargetValue),
+	}
+}
@@ -0,0 +1,250 @@
+package mackerel
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/typeutil"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+// Alerts are listed from the newest one, two alerts per page.
+var testAlerts = []*mackerel.Alert{
+	{ID: "alert5", Status: "CRITICAL", MonitorID: "mon-service", Type: "service", Value: 10, OpenedAt: 1700000500},
+	{ID: "alert4", Status: "WARNING", MonitorID: "mon-host", Type: "host", HostID: "host-app", Value: 80, OpenedAt: 1700000400},
+	{ID: "alert3", Status: "OK", MonitorID: "mon-host", Type: "host", HostID: "host-db", Value: 10, OpenedAt: 1700000300, ClosedAt: 1700000350},
+	{ID: "alert2", Status: "CRITICAL", MonitorID: "mon-conn", Type: "connectivity", HostID: "host-db", OpenedAt: 1700000200},
+	{ID: "alert1", Status: "CRITICAL", MonitorID: "mon-expr", Type: "expression", Value: 1, OpenedAt: 1700000100},
+}
+
+func Test_ReadAlerts(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		in        AlertsModel
+		wantIDs   []string
+		wantID    string
+		wantPages int
+	}{
+		"open": {
+			in:        AlertsModel{},
+			wantIDs:   []string{"alert5", "alert4", "alert2", "alert1"},
+			wantID:    "open",
+			wantPages: 2,
+		},
+		"with closed": {
+			in:        AlertsModel{WithClosed: types.BoolValue(true)},
+			wantIDs:   []string{"alert5", "alert4", "alert3", "alert2", "alert1"},
+			wantID:    "withClosed=true",
+			wantPages: 3,
+		},
+		"statuses and monitor types": {
+			in: AlertsModel{
+				Statuses:     []string{"CRITICAL"},
+				MonitorTypes: []string{"connectivity", "service"},
+			},
+			wantIDs:   []string{"alert5", "alert2"},
+			wantID:    "monitorType=connectivity&monitorType=service&status=CRITICAL",
+			wantPages: 2,
+		},
+		"monitor and host": {
+			in: AlertsModel{
+				WithClosed: types.BoolValue(true),
+				MonitorIDs: []string{"mon-host"},
+				HostIDs:    []string{"host-db"},
+			},
+			wantIDs:   []string{"alert3"},
+			wantID:    "hostId=host-db&monitorId=mon-host&withClosed=true",
+			wantPages: 3,
+		},
+		"service": {
+			in:        AlertsModel{Service: types.StringValue("app")},
+			wantIDs:   []string{"alert5", "alert4", "alert2"},
+			wantID:    "service=app",
+			wantPages: 2,
+		},
+		"role": {
+			in:        AlertsModel{RoleFullname: types.StringValue("app:db")},
+			wantIDs:   []string{"alert2"},
+			wantID:    "role=db&service=app",
+			wantPages: 2,
+		},
+		"time range": {
+			in: AlertsModel{
+				From: typeutil.NewTimeStringValue("1700000200"),
+				To:   typeutil.NewTimeStringValue("2023-11-14T22:20:00Z"), // 1700000400
+			},
+			wantIDs:   []string{"alert4", "alert2"},
+			wantID:    "from=1700000200&to=1700000400",
+			wantPages: 2,
+		},
+		"limit": {
+			in:        AlertsModel{Limit: types.Int64Value(2)},
+			wantIDs:   []string{"alert5", "alert4"},
+			wantID:    "limit=2",
+			wantPages: 1,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &alertsReaderMock{}
+			data, err := readAlertsInner(context.Background(), client, tt.in)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if diff := cmp.Diff(tt.wantIDs, data.IDs); diff != "" {
+				t.Error(diff)
+			}
+			if got := data.ID.ValueString(); got != tt.wantID {
+				t.Errorf("expected ID to be '%s', but got '%s'", tt.wantID, got)
+			}
+			if client.pages != tt.wantPages {
+				t.Errorf("expected to read %d pages, but read %d pages", tt.wantPages, client.pages)
+			}
+			for _, a := range data.Alerts {
+				if a.Logs != nil {
+					t.Errorf("expected no logs, but got: %+v", a.Logs)
+				}
+			}
+		})
+	}
+}
+
+func Test_ReadAlerts_withLogs(t *testing.T) {
+	t.Parallel()
+
+	data, err := readAlertsInner(context.Background(), &alertsReaderMock{}, AlertsModel{
+		WithClosed: types.BoolValue(true),
+		HostIDs:    []string{"host-db"},
+		Limit:      types.Int64Value(1),
+		WithLogs:   types.BoolValue(true),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	monitorID := "mon-host"
+	wants := []AlertModel{{
+		ID:        types.StringValue("alert3"),
+		Status:    types.StringValue("OK"),
+		MonitorID: types.StringValue("mon-host"),
+		Type:      types.StringValue("host"),
+		HostID:    types.StringValue("host-db"),
+		Value:     types.Float64Value(10),
+		Message:   types.StringValue(""),
+		Reason:    types.StringValue(""),
+		Memo:      types.StringValue(""),
+		OpenedAt:  types.Int64Value(1700000300),
+		ClosedAt:  types.Int64Value(1700000350),
+		Logs: []AlertLogModel{
+			{
+				ID:          types.StringValue("alert3-log0"),
+				CreatedAt:   types.Int64Value(1700000350),
+				Status:      types.StringValue("OK"),
+				Trigger:     types.StringValue("monitoring"),
+				MonitorID:   types.StringPointerValue(&monitorID),
+				TargetValue: types.Float64Null(),
+			},
+			{
+				ID:          types.StringValue("alert3-log1"),
+				CreatedAt:   types.Int64Value(1700000300),
+				Status:      types.StringValue("CRITICAL"),
+				Trigger:     types.StringValue("monitoring"),
+				MonitorID:   types.StringPointerValue(&monitorID),
+				TargetValue: types.Float64Null(),
+			},
+		},
+	}}
+	if diff := cmp.Diff(wants, data.Alerts); diff != "" {
+		t.Error(diff)
+	}
+}
+
+type alertsReaderMock struct {
+	pages int
+}
+
+func (m *alertsReaderMock) page(withClosed bool, nextID string) (*mackerel.AlertsResp, error) {
+	m.pages++
+	alerts := []*mackerel.Alert{}
+	for _, a := range testAlerts {
+		if withClosed || a.Status != "OK" {
+			alerts = append(alerts, a)
+		}
+	}
+
+	start := 0
+	if nextID != "" {
+		if _, err := fmt.Sscanf(nextID, "page%d", &start); err != nil {
+			return nil, err
+		}
+	}
+	end := min(start+2, len(alerts))
+	resp := &mackerel.AlertsResp{Alerts: alerts[start:end]}
+	if end < len(alerts) {
+		resp.NextID = fmt.Sprintf("page%d", end)
+	}
+	return resp, nil
+}
+
+func (m *alertsReaderMock) FindAlertsContext(context.Context) (*mackerel.AlertsResp, error) {
+	return m.page(false, "")
+}
+
+func (m *alertsReaderMock) FindAlertsByNextIDContext(_ context.Context, nextID string) (*mackerel.AlertsResp, error) {
+	return m.page(false, nextID)
+}
+
+func (m *alertsReaderMock) FindWithClosedAlertsContext(context.Context) (*mackerel.AlertsResp, error) {
+	return m.page(true, "")
+}
+
+func (m *alertsReaderMock) FindWithClosedAlertsByNextIDContext(_ context.Context, nextID string) (*mackerel.AlertsResp, error) {
+	return m.page(true, nextID)
+}
+
+func (m *alertsReaderMock) FindHostsContext(_ context.Context, param *mackerel.FindHostsParam) ([]*mackerel.Host, error) {
+	if param.Service != "app" {
+		return nil, nil
+	}
+	hosts := []*mackerel.Host{{ID: "host-app", Roles: mackerel.Roles{"app": {"web"}}}, {ID: "host-db", Roles: mackerel.Roles{"app": {"db"}}}}
+	if len(param.Roles) == 0 {
+		return hosts, nil
+	}
+	var found []*mackerel.Host
+	for _, h := range hosts {
+		if h.Roles["app"][0] == param.Roles[0] {
+			found = append(found, h)
+		}
+	}
+	return found, nil
+}
+
+func (m *alertsReaderMock) FindMonitorsContext(context.Context) ([]mackerel.Monitor, error) {
+	return []mackerel.Monitor{
+		&mackerel.MonitorServiceMetric{ID: "mon-service", Service: "app"},
+		&mackerel.MonitorHostMetric{ID: "mon-host"},
+		&mackerel.MonitorConnectivity{ID: "mon-conn"},
+		&mackerel.MonitorExpression{ID: "mon-expr"},
+	}, nil
+}
+
+// Returns two logs of the alert, one per page.
+func (m *alertsReaderMock) FindAlertLogsContext(_ context.Context, id string, param *mackerel.FindAlertLogsParam) (*mackerel.FindAlertLogsResp, error) {
+	monitorID := "mon-host"
+	if param.NextId == nil {
+		return &mackerel.FindAlertLogsResp{
+			AlertLogs: []*mackerel.AlertLog{{ID: id + "-log0", CreatedAt: 1700000350, Status: "OK", Trigger: "monitoring", MonitorID: &monitorID}},
+			NextID:    "next",
+		}, nil
+	}
+	return &mackerel.FindAlertLogsResp{
+		AlertLogs: []*mackerel.AlertLog{{ID: id + "-log1", CreatedAt: 1700000300, Status: "CRITICAL", Trigger: "monitoring", MonitorID: &monitorID}},
+	}, nil
+}
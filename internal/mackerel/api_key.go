@@ -0,0 +1,250 @@
+package mackerel
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Resolves the API key from the sources which are not set directly.
+// The sources are tried in the following order, and the first one which is configured is used:
+//
+//  1. api_key_file
+//  2. api_key_command
+//  3. mackerel_agent_config_path
+//  4. MACKEREL_APIKEY or MACKEREL_API_KEY environment variable
+//  5. api_key
+//
+// APIKey holds either of the last two, as MergeAPIKeySources has chosen.
+//
+// read_api_key and write_api_key take precedence over these sources for reads and writes respectively.
+//
+// api_base is taken from the mackerel-agent config only if the API key comes from it too.
+func (m *ClientConfigModel) ResolveAPIKey(ctx context.Context) error {
+	switch {
+	case !m.APIKeyFile.IsNull() && !m.APIKeyFile.IsUnknown():
+		path := m.APIKeyFile.ValueString()
+		m.apiKeySource = fmt.Sprintf("api_key_file (%s)", path)
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read the API key from %s: %w", m.apiKeySource, err)
+		}
+		return m.setResolvedAPIKey(string(b))
+
+	case !m.APIKeyCommand.IsNull() && !m.APIKeyCommand.IsUnknown():
+		var args []string
+		if diags := m.APIKeyCommand.ElementsAs(ctx, &args, false); diags.HasError() {
+			return fmt.Errorf("invalid api_key_command: %v", diags)
+		}
+		if len(args) == 0 {
+			return errors.New("api_key_command must not be empty")
+		}
+		m.apiKeySource = fmt.Sprintf("api_key_command (%s)", args[0])
+		out, err := runAPIKeyCommand(ctx, args)
+		if err != nil {
+			return fmt.Errorf("failed to get the API key from %s: %w", m.apiKeySource, err)
+		}
+		return m.setResolvedAPIKey(out)
+
+	case !m.MackerelAgentConfigPath.IsNull() && !m.MackerelAgentConfigPath.IsUnknown():
+		path := m.MackerelAgentConfigPath.ValueString()
+		m.apiKeySource = fmt.Sprintf("mackerel_agent_config_path (%s)", path)
+		conf, err := readAgentConfig(path)
+		if err != nil {
+			return fmt.Errorf("failed to read the API key from %s: %w", m.apiKeySource, err)
+		}
+		if err := m.setResolvedAPIKey(conf.apiKey); err != nil {
+			return err
+		}
+		if m.APIBase.IsNull() && conf.apiBase != "" {
+			m.APIBase = types.StringValue(conf.apiBase)
+		}
+		return nil
+
+	case !m.APIKey.IsNull() && !m.APIKey.IsUnknown():
+		if m.apiKeySource == "" {
+			m.apiKeySource = "api_key"
+		}
+		return nil
+
+	case m.hasScopedAPIKeys():
+		m.apiKeySource = "read_api_key/write_api_key"
+		return nil
+
+	default:
+		return ErrNoAPIKey
+	}
+}
+
+func (m *ClientConfigModel) hasScopedAPIKeys() bool {
+	return m.ReadAPIKey.ValueString() != "" || m.WriteAPIKey.ValueString() != ""
+}
+
+// Returns the API keys for reads and writes.
+// The key for writes is empty if only a read key is configured.
+func (m *ClientConfigModel) scopedAPIKeys() (readKey, writeKey string) {
+	apiKey := m.APIKey.ValueString()
+	writeKey = cmp.Or(m.WriteAPIKey.ValueString(), apiKey)
+	readKey = cmp.Or(m.ReadAPIKey.ValueString(), apiKey, writeKey)
+	return readKey, writeKey
+}
+
+// Describes where the API key is resolved from, e.g. "api_key_file (/path/to/key)".
+// It never contains the API key itself.
+func (m *ClientConfigModel) APIKeySource() string {
+	return m.apiKeySource
+}
+
+func (m *ClientConfigModel) setResolvedAPIKey(key string) error {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return fmt.Errorf("the API key from %s is empty", m.apiKeySource)
+	}
+	m.APIKey = types.StringValue(key)
+	return nil
+}
+
+func runAPIKeyCommand(ctx context.Context, args []string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%w: %s", err, msg)
+		}
+		return "", err
+	}
+	return stdout.String(), nil
+}
+
+type agentConfig struct {
+	apiKey  string
+	apiBase string
+}
+
+// Reads `apikey` and `apibase` from the config file of mackerel-agent.
+// Only top-level string values are supported, which is enough for these keys.
+func readAgentConfig(path string) (agentConfig, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return agentConfig{}, err
+	}
+	defer f.Close()
+
+	var conf agentConfig
+	s := bufio.NewScanner(f)
+	for lineno := 1; s.Scan(); lineno++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		// The rest of the file belongs to tables such as [plugin.metrics.foo].
+		if strings.HasPrefix(line, "[") {
+			break
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		key = strings.Trim(strings.TrimSpace(key), `"`)
+		if key != "apikey" && key != "apibase" {
+			continue
+		}
+		v, err := parseTOMLString(strings.TrimSpace(value))
+		if err != nil {
+			return agentConfig{}, fmt.Errorf("line %d: invalid value of %s: %w", lineno, key, err)
+		}
+		if key == "apikey" {
+			conf.apiKey = v
+		} else {
+			conf.apiBase = v
+		}
+	}
+	if err := s.Err(); err != nil {
+		return agentConfig{}, err
+	}
+	return conf, nil
+}
+
+// Parses a single-line TOML string, which may be followed by a comment.
+func parseTOMLString(s string) (string, error) {
+	if s == "" {
+		return "", errors.New("empty value")
+	}
+	switch s[0] {
+	case '\'':
+		end := strings.IndexByte(s[1:], '\'')
+		if end < 0 {
+			return "", errors.New("unterminated string")
+		}
+		return s[1 : end+1], trailingComment(s[end+2:])
+	case '"':
+		for i := 1; i < len(s); i++ {
+			switch s[i] {
+			case '\\':
+				i++
+			case '"':
+				v, err := strconv.Unquote(s[:i+1])
+				if err != nil {
+					return "", err
+				}
+				return v, trailingComment(s[i+1:])
+			}
+		}
+		return "", errors.New("unterminated string")
+	default:
+		return "", errors.New("not a string")
+	}
+}
+
+func trailingComment(s string) error {
+	s = strings.TrimSpace(s)
+	if s != "" && !strings.HasPrefix(s, "#") {
+		return fmt.Errorf("unexpected %q after string", s)
+	}
+	return nil
+}
+
+// ErrNoWriteAPIKey is returned for write requests when only a read-only API key is configured.
+var ErrNoWriteAPIKey = errors.New("no API key for writes is configured: set write_api_key or api_key to create, update or delete objects")
+
+// apiKeyTransport sends reads with the read key and writes with the write key.
+// Writes fail without being sent if there is no write key.
+type apiKeyTransport struct {
+	base     http.RoundTripper
+	readKey  string
+	writeKey string
+}
+
+var _ http.RoundTripper = (*apiKeyTransport)(nil)
+
+func (t *apiKeyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := t.readKey
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+	default:
+		if t.writeKey == "" {
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, ErrNoWriteAPIKey
+		}
+		key = t.writeKey
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Set("X-Api-Key", key)
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,404 @@
+package mackerel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+func Test_ClientConfig_ResolveAPIKey(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "api_key")
+	if err := os.WriteFile(keyFile, []byte("key_from_file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	emptyFile := filepath.Join(dir, "empty")
+	if err := os.WriteFile(emptyFile, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	agentConf := filepath.Join(dir, "mackerel-agent.conf")
+	if err := os.WriteFile(agentConf, []byte(`
+# comment
+apikey = "key_from_agent" # trailing comment
+apibase = 'https://api.example.com'
+
+[host_status]
+on_start = "working"
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		in         ClientConfigModel
+		wantKey    types.String
+		wantBase   types.String
+		wantSource string
+		wantErr    bool
+		wantNoKey  bool
+	}{
+		"api_key": {
+			in: ClientConfigModel{
+				APIKey: types.StringValue("key"),
+			},
+			wantKey:    types.StringValue("key"),
+			wantSource: "api_key",
+		},
+		"env": {
+			in: ClientConfigModel{
+				APIKey:       types.StringValue("key"),
+				apiKeySource: "MACKEREL_APIKEY environment variable",
+			},
+			wantKey:    types.StringValue("key"),
+			wantSource: "MACKEREL_APIKEY environment variable",
+		},
+		"file": {
+			in: ClientConfigModel{
+				APIKey:                  types.StringValue("key"),
+				APIKeyFile:              types.StringValue(keyFile),
+				APIKeyCommand:           types.ListValueMust(types.StringType, []attr.Value{types.StringValue("false")}),
+				MackerelAgentConfigPath: types.StringValue(agentConf),
+			},
+			wantKey:    types.StringValue("key_from_file"),
+			wantSource: "api_key_file (" + keyFile + ")",
+		},
+		"agent config": {
+			in: ClientConfigModel{
+				MackerelAgentConfigPath: types.StringValue(agentConf),
+			},
+			wantKey:    types.StringValue("key_from_agent"),
+			wantBase:   types.StringValue("https://api.example.com"),
+			wantSource: "mackerel_agent_config_path (" + agentConf + ")",
+		},
+		"agent config with api_base": {
+			in: ClientConfigModel{
+				APIBase:                 types.StringValue("https://api.mackerelio.com"),
+				MackerelAgentConfigPath: types.StringValue(agentConf),
+			},
+			wantKey:    types.StringValue("key_from_agent"),
+			wantBase:   types.StringValue("https://api.mackerelio.com"),
+			wantSource: "mackerel_agent_config_path (" + agentConf + ")",
+		},
+		"empty file": {
+			in: ClientConfigModel{
+				APIKeyFile: types.StringValue(emptyFile),
+			},
+			wantErr: true,
+		},
+		"missing file": {
+			in: ClientConfigModel{
+				APIKeyFile: types.StringValue(filepath.Join(dir, "missing")),
+			},
+			wantErr: true,
+		},
+		"no key": {
+			wantErr:   true,
+			wantNoKey: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := tt.in
+			err := config.ResolveAPIKey(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if errors.Is(err, ErrNoAPIKey) != tt.wantNoKey {
+				t.Errorf("unexpected error: %+v", err)
+			}
+			if err != nil {
+				return
+			}
+			if config.APIKey != tt.wantKey {
+				t.Errorf("expected API key %s, but got %s", tt.wantKey, config.APIKey)
+			}
+			if config.APIBase != tt.wantBase {
+				t.Errorf("expected API base %s, but got %s", tt.wantBase, config.APIBase)
+			}
+			if diff := cmp.Diff(tt.wantSource, config.APIKeySource()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func Test_ClientConfig_MergeAPIKeySources(t *testing.T) {
+	t.Parallel()
+
+	const envSource = "MACKEREL_APIKEY environment variable"
+	command := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("echo"), types.StringValue("key_from_command")})
+
+	cases := map[string]struct {
+		inEnv   types.String
+		inBlock ClientConfigModel
+
+		wantKey     types.String
+		wantSource  string
+		wantIgnored []string
+	}{
+		"env only": {
+			inEnv: types.StringValue("key_from_env"),
+
+			wantKey:    types.StringValue("key_from_env"),
+			wantSource: envSource,
+		},
+		"env overrides api_key": {
+			inEnv:   types.StringValue("key_from_env"),
+			inBlock: ClientConfigModel{APIKey: types.StringValue("key")},
+
+			wantKey:     types.StringValue("key_from_env"),
+			wantSource:  envSource,
+			wantIgnored: []string{"api_key"},
+		},
+		"api_key_command overrides env": {
+			inEnv:   types.StringValue("key_from_env"),
+			inBlock: ClientConfigModel{APIKeyCommand: command},
+
+			wantKey:     types.StringValue("key_from_command"),
+			wantSource:  "api_key_command (echo)",
+			wantIgnored: []string{envSource},
+		},
+		"api_key_command overrides env and api_key": {
+			inEnv: types.StringValue("key_from_env"),
+			inBlock: ClientConfigModel{
+				APIKey:        types.StringValue("key"),
+				APIKeyCommand: command,
+			},
+
+			wantKey:     types.StringValue("key_from_command"),
+			wantSource:  "api_key_command (echo)",
+			wantIgnored: []string{envSource, "api_key"},
+		},
+		"scoped keys fall back to env": {
+			inEnv:   types.StringValue("key_from_env"),
+			inBlock: ClientConfigModel{ReadAPIKey: types.StringValue("read_key")},
+
+			wantKey:    types.StringValue("key_from_env"),
+			wantSource: envSource,
+		},
+		"no env": {
+			inEnv:   types.StringNull(),
+			inBlock: ClientConfigModel{APIKey: types.StringValue("key")},
+
+			wantKey:    types.StringValue("key"),
+			wantSource: "api_key",
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if runtime.GOOS == "windows" && !tt.inBlock.APIKeyCommand.IsNull() {
+				t.Skip("echo is not an executable on windows")
+			}
+
+			config := ClientConfigModel{APIKey: tt.inEnv}
+			if !tt.inEnv.IsNull() {
+				config.apiKeySource = envSource
+			}
+			config.MergeAPIKeySources(tt.inBlock)
+			if err := config.ResolveAPIKey(context.Background()); err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if config.APIKey != tt.wantKey {
+				t.Errorf("expected API key %s, but got %s", tt.wantKey, config.APIKey)
+			}
+			if diff := cmp.Diff(tt.wantSource, config.APIKeySource()); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(tt.wantIgnored, config.IgnoredAPIKeySources()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func Test_ClientConfig_ResolveAPIKey_command(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("echo is not an executable on windows")
+	}
+
+	cases := map[string]struct {
+		args    []string
+		want    types.String
+		wantErr bool
+	}{
+		"ok": {
+			args: []string{"echo", "key_from_command"},
+			want: types.StringValue("key_from_command"),
+		},
+		"failed": {
+			args:    []string{"false"},
+			wantErr: true,
+		},
+		"empty output": {
+			args:    []string{"true"},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			args := make([]attr.Value, len(tt.args))
+			for i, arg := range tt.args {
+				args[i] = types.StringValue(arg)
+			}
+			config := ClientConfigModel{
+				APIKeyCommand: types.ListValueMust(types.StringType, args),
+			}
+			err := config.ResolveAPIKey(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if err == nil && config.APIKey != tt.want {
+				t.Errorf("expected API key %s, but got %s", tt.want, config.APIKey)
+			}
+		})
+	}
+}
+
+func Test_parseTOMLString(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		"basic":         {in: `"abc"`, want: "abc"},
+		"escaped":       {in: `"a\"b\\c"`, want: `a"b\c`},
+		"literal":       {in: `'a\b'`, want: `a\b`},
+		"comment":       {in: `"abc" # comment`, want: "abc"},
+		"not a string":  {in: `123`, wantErr: true},
+		"unterminated":  {in: `"abc`, wantErr: true},
+		"trailing junk": {in: `"abc" def`, wantErr: true},
+		"empty":         {in: ``, wantErr: true},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseTOMLString(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("expected %q, but got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_ClientConfig_scopedAPIKeys(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	requests := map[string]string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.Method+" "+r.URL.Path] = r.Header.Get("X-Api-Key")
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"services":[]}`)
+		default:
+			fmt.Fprint(w, `{"name":"svc","memo":"","roles":[]}`)
+		}
+	}))
+	t.Cleanup(ts.Close)
+
+	cases := map[string]struct {
+		in          ClientConfigModel
+		wantRead    string
+		wantWrite   string
+		wantNoWrite bool
+	}{
+		"api_key": {
+			in:        ClientConfigModel{APIKey: types.StringValue("key")},
+			wantRead:  "key",
+			wantWrite: "key",
+		},
+		"read and write keys": {
+			in: ClientConfigModel{
+				APIKey:      types.StringValue("key"),
+				ReadAPIKey:  types.StringValue("read"),
+				WriteAPIKey: types.StringValue("write"),
+			},
+			wantRead:  "read",
+			wantWrite: "write",
+		},
+		"read key with api_key": {
+			in: ClientConfigModel{
+				APIKey:     types.StringValue("key"),
+				ReadAPIKey: types.StringValue("read"),
+			},
+			wantRead:  "read",
+			wantWrite: "key",
+		},
+		"write key only": {
+			in:        ClientConfigModel{WriteAPIKey: types.StringValue("write")},
+			wantRead:  "write",
+			wantWrite: "write",
+		},
+		"read key only": {
+			in:          ClientConfigModel{ReadAPIKey: types.StringValue("read")},
+			wantRead:    "read",
+			wantNoWrite: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			config := tt.in
+			config.APIBase = types.StringValue(ts.URL)
+			config.MaxRetries = types.Int64Value(0)
+			if err := config.ResolveAPIKey(t.Context()); err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			client, err := config.NewClient()
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			clear(requests)
+
+			if _, err := client.FindServicesContext(t.Context()); err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			_, err = client.CreateServiceContext(t.Context(), &mackerel.CreateServiceParam{Name: "svc"})
+			if errors.Is(err, ErrNoWriteAPIKey) != tt.wantNoWrite {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			if got := requests["GET /api/v0/services"]; got != tt.wantRead {
+				t.Errorf("expected to read with %q, but got %q", tt.wantRead, got)
+			}
+			if got, ok := requests["POST /api/v0/services"]; tt.wantNoWrite == ok || got != tt.wantWrite {
+				t.Errorf("expected to write with %q, but got %q", tt.wantWrite, got)
+			}
+		})
+	}
+}
@@ -96,20 +96,20 @@ type AWSIntegrationServiceWithRetireAutomatically struct {
 
 type AWSIntegrationServiceWithRetireAutomaticallyOpt []AWSIntegrationServiceWithRetireAutomatically // length <= 1
 
-func ReadAWSIntegration(_ context.Context, client *Client, id string) (*AWSIntegrationModel, error) {
-	return readAWSIntegration(client, id)
+func ReadAWSIntegration(ctx context.Context, client *Client, id string) (*AWSIntegrationModel, error) {
+	return readAWSIntegration(ctx, client, id)
 }
 
-func readAWSIntegration(client *Client, id string) (*AWSIntegrationModel, error) {
-	mackerelAWSIntegration, err := client.FindAWSIntegration(id)
+func readAWSIntegration(ctx context.Context, client *Client, id string) (*AWSIntegrationModel, error) {
+	mackerelAWSIntegration, err := client.FindAWSIntegrationContext(ctx, id)
 	if err != nil {
-		return nil, err
+		return nil, wrapNotFound(err)
 	}
 	return newAWSIntegrationModel(*mackerelAWSIntegration)
 }
 
-func (m *AWSIntegrationModel) Create(_ context.Context, client *Client) error {
-	newIntegration, err := client.CreateAWSIntegration(m.createParam())
+func (m *AWSIntegrationModel) Create(ctx context.Context, client *Client) error {
+	newIntegration, err := client.CreateAWSIntegrationContext(ctx, m.createParam())
 	if err != nil {
 		return err
 	}
@@ -118,8 +118,8 @@ func (m *AWSIntegrationModel) Create(_ context.Context, client *Client) error {
 	return nil
 }
 
-func (m *AWSIntegrationModel) Read(_ context.Context, client *Client) error {
-	integration, err := readAWSIntegration(client, m.ID.ValueString())
+func (m *AWSIntegrationModel) Read(ctx context.Context, client *Client) error {
+	integration, err := readAWSIntegration(ctx, client, m.ID.ValueString())
 	if err != nil {
 		return err
 	}
@@ -148,15 +148,15 @@ func (m *AWSIntegrationModel) Read(_ context.Context, client *Client) error {
 	return nil
 }
 
-func (m *AWSIntegrationModel) Update(_ context.Context, client *Client) error {
-	if _, err := client.UpdateAWSIntegration(m.ID.ValueString(), m.updateParam()); err != nil {
+func (m *AWSIntegrationModel) Update(ctx context.Context, client *Client) error {
+	if _, err := client.UpdateAWSIntegrationContext(ctx, m.ID.ValueString(), m.updateParam()); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (m *AWSIntegrationModel) Delete(_ context.Context, client *Client) error {
-	if _, err := client.DeleteAWSIntegration(m.ID.ValueString()); err != nil {
+func (m *AWSIntegrationModel) Delete(ctx context.Context, client *Client) error {
+	if _, err := client.DeleteAWSIntegrationContext(ctx, m.ID.ValueString()); err != nil {
 		return err
 	}
 	return nil
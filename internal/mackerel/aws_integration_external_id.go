@@ -0,0 +1,50 @@
+package mackerel
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AWSIntegrationExternalIDModel is an external ID for the trust policy of the IAM role of an AWS integration.
+// Mackerel API can only generate external IDs, so they are neither read nor deleted.
+//
+// The external ID is a secret shared with the trust policy, so the ID of the resource is derived from it
+// not to show the external ID in plans and states.
+type AWSIntegrationExternalIDModel struct {
+	ID         types.String `tfsdk:"id"`
+	ExternalID types.String `tfsdk:"external_id"`
+}
+
+func ImportAWSIntegrationExternalID(externalID string) AWSIntegrationExternalIDModel {
+	return AWSIntegrationExternalIDModel{
+		ID:         types.StringValue(awsIntegrationExternalIDResourceID(externalID)),
+		ExternalID: types.StringValue(externalID),
+	}
+}
+
+// Returns the first 16 hex digits of the SHA-256 hash of the external ID.
+func awsIntegrationExternalIDResourceID(externalID string) string {
+	sum := sha256.Sum256([]byte(externalID))
+	return hex.EncodeToString(sum[:8])
+}
+
+// Generates an external ID.
+func (m *AWSIntegrationExternalIDModel) Create(ctx context.Context, client *Client) error {
+	return m.createInner(ctx, client)
+}
+
+type awsIntegrationExternalIDCreator interface {
+	CreateAWSIntegrationExternalIDContext(context.Context) (string, error)
+}
+
+func (m *AWSIntegrationExternalIDModel) createInner(ctx context.Context, client awsIntegrationExternalIDCreator) error {
+	externalID, err := client.CreateAWSIntegrationExternalIDContext(ctx)
+	if err != nil {
+		return err
+	}
+	*m = ImportAWSIntegrationExternalID(externalID)
+	return nil
+}
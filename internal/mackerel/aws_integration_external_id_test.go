@@ -0,0 +1,59 @@
+package mackerel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Test_AWSIntegrationExternalID_createInner(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		inID    string
+		inErr   error
+		wants   AWSIntegrationExternalIDModel
+		wantErr bool
+	}{
+		"basic": {
+			inID: "0123456789abcdef0123456789abcdef01234567",
+			wants: AWSIntegrationExternalIDModel{
+				ID:         types.StringValue("deb87fabd17715bb"),
+				ExternalID: types.StringValue("0123456789abcdef0123456789abcdef01234567"),
+			},
+		},
+		"error": {
+			inErr:   errors.New("forbidden"),
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := awsIntegrationExternalIDCreatorFunc(func() (string, error) {
+				return tt.inID, tt.inErr
+			})
+			var data AWSIntegrationExternalIDModel
+			if err := data.createInner(context.Background(), client); (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.wants, data); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+type awsIntegrationExternalIDCreatorFunc func() (string, error)
+
+func (f awsIntegrationExternalIDCreatorFunc) CreateAWSIntegrationExternalIDContext(context.Context) (string, error) {
+	return f()
+}
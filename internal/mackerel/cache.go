@@ -0,0 +1,111 @@
+package mackerel
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+const (
+	cacheKeyChannels           = "channels"
+	cacheKeyDowntimes          = "downtimes"
+	cacheKeyMonitors           = "monitors"
+	cacheKeyNotificationGroups = "notification_groups"
+	cacheKeyServices           = "services"
+)
+
+func cacheKeyRoles(serviceName string) string {
+	return "roles/" + serviceName
+}
+
+// listCache holds results of list APIs during a run of the provider.
+// Concurrent lookups of the same collection are de-duplicated into a single API call.
+//
+// Cached values are shared between callers, so they must not be modified.
+type listCache struct {
+	mu      sync.Mutex
+	entries map[string]*listCacheEntry
+}
+
+type listCacheEntry struct {
+	done  chan struct{}
+	value any
+	err   error
+}
+
+func newListCache() *listCache {
+	return &listCache{entries: map[string]*listCacheEntry{}}
+}
+
+// Returns the cached value of the key, or calls fetch to fill it.
+// Errors are not cached.
+func cachedList[T any](ctx context.Context, c *listCache, key string, fetch func(context.Context) (T, error)) (T, error) {
+	if c == nil {
+		return fetch(ctx)
+	}
+
+	for {
+		c.mu.Lock()
+		e, ok := c.entries[key]
+		if !ok {
+			e = &listCacheEntry{done: make(chan struct{})}
+			c.entries[key] = e
+			c.mu.Unlock()
+
+			e.value, e.err = fetch(ctx)
+			close(e.done)
+			if e.err != nil {
+				c.mu.Lock()
+				if c.entries[key] == e {
+					delete(c.entries, key)
+				}
+				c.mu.Unlock()
+			}
+		} else {
+			c.mu.Unlock()
+			select {
+			case <-e.done:
+			case <-ctx.Done():
+				var zero T
+				return zero, ctx.Err()
+			}
+			// The caller which started fetching has been canceled, so fetch again by this caller.
+			if e.err != nil && isContextError(e.err) && ctx.Err() == nil {
+				continue
+			}
+		}
+
+		if e.err != nil {
+			var zero T
+			return zero, e.err
+		}
+		return e.value.(T), nil
+	}
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// Drops the cached collections.
+func (c *listCache) invalidate(keys ...string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		delete(c.entries, key)
+	}
+}
+
+// Drops all cached collections.
+// Deleting an object may also change other collections which refer to it.
+func (c *listCache) invalidateAll() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+}
@@ -0,0 +1,133 @@
+package mackerel
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_listCache_singleFlight(t *testing.T) {
+	t.Parallel()
+
+	c := newListCache()
+	var calls atomic.Int32
+	fetch := func(context.Context) ([]string, error) {
+		calls.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		return []string{"a", "b"}, nil
+	}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := cachedList(context.Background(), c, "key", fetch)
+			if err != nil {
+				t.Errorf("unexpected error: %+v", err)
+			}
+			if len(v) != 2 {
+				t.Errorf("unexpected value: %v", v)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected to be fetched once, but got %d times", got)
+	}
+}
+
+func Test_listCache_invalidate(t *testing.T) {
+	t.Parallel()
+
+	c := newListCache()
+	calls := map[string]int{}
+	fetch := func(key string) func(context.Context) (int, error) {
+		return func(context.Context) (int, error) {
+			calls[key]++
+			return calls[key], nil
+		}
+	}
+
+	steps := []struct {
+		do   func()
+		key  string
+		want int
+	}{
+		{key: "a", want: 1},
+		{key: "a", want: 1},
+		{key: "b", want: 1},
+		{do: func() { c.invalidate("a") }, key: "a", want: 2},
+		{key: "b", want: 1},
+		{do: func() { c.invalidateAll() }, key: "b", want: 2},
+		{key: "a", want: 3},
+	}
+	for i, step := range steps {
+		if step.do != nil {
+			step.do()
+		}
+		got, err := cachedList(context.Background(), c, step.key, fetch(step.key))
+		if err != nil {
+			t.Fatalf("step %d: unexpected error: %+v", i, err)
+		}
+		if got != step.want {
+			t.Errorf("step %d: expected %d, but got %d", i, step.want, got)
+		}
+	}
+}
+
+func Test_listCache_errorIsNotCached(t *testing.T) {
+	t.Parallel()
+
+	c := newListCache()
+	if _, err := cachedList(context.Background(), c, "key", func(context.Context) (int, error) {
+		return 0, fmt.Errorf("temporary error")
+	}); err == nil {
+		t.Fatal("expected error, but got no error")
+	}
+
+	got, err := cachedList(context.Background(), c, "key", func(context.Context) (int, error) { return 1, nil })
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if got != 1 {
+		t.Errorf("expected 1, but got %d", got)
+	}
+}
+
+func Test_listCache_canceledFetcher(t *testing.T) {
+	t.Parallel()
+
+	c := newListCache()
+	started := make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		_, _ = cachedList(ctx, c, "key", func(ctx context.Context) (int, error) {
+			close(started)
+			<-ctx.Done()
+			return 0, ctx.Err()
+		})
+	}()
+	<-started
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		got, err := cachedList(context.Background(), c, "key", func(context.Context) (int, error) {
+			return 1, nil
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %+v", err)
+		}
+		if got != 1 {
+			t.Errorf("expected 1, but got %d", got)
+		}
+	}()
+	cancel()
+	<-done
+}
@@ -35,9 +35,8 @@ type (
 )
 
 // Reads a channel by the ID.
-// Currently this function is NOT cancelable.
-func ReadChannel(_ context.Context, client *Client, id string) (ChannelModel, error) {
-	channels, err := client.FindChannels()
+func ReadChannel(ctx context.Context, client *Client, id string) (ChannelModel, error) {
+	channels, err := client.FindChannelsContext(ctx)
 	if err != nil {
 		return ChannelModel{}, err
 	}
@@ -46,32 +45,38 @@ func ReadChannel(_ context.Context, client *Client, id string) (ChannelModel, er
 		return c.ID == id
 	})
 	if channelIdx < 0 {
-		return ChannelModel{}, fmt.Errorf("the ID '%s' does not match any channel in mackerel.io", id)
+		return ChannelModel{}, newNotFoundError("the ID '%s' does not match any channel in mackerel.io", id)
 	}
 
 	channel, err := newChannel(*channels[channelIdx])
 	if err != nil {
 		return ChannelModel{}, err
 	}
+	channel.Name = client.trimNamePrefix(channel.Name)
 
 	return channel, nil
 }
 
 // Creates a new channel.
-// Currently this function is NOT cancelable.
 func (m *ChannelModel) Create(ctx context.Context, client *Client) error {
-	return m.createInner(ctx, client)
+	param := *m
+	param.Name = client.prefixName(m.Name)
+	if err := param.createInner(ctx, client); err != nil {
+		return err
+	}
+	m.ID = param.ID
+	return nil
 }
 
 type channelCreator interface {
-	CreateChannel(*mackerel.Channel) (*mackerel.Channel, error)
+	CreateChannelContext(context.Context, *mackerel.Channel) (*mackerel.Channel, error)
 }
 
-func (m *ChannelModel) createInner(_ context.Context, client channelCreator) error {
+func (m *ChannelModel) createInner(ctx context.Context, client channelCreator) error {
 	channelParam := m.mackerelChannel()
 	addToDefaultNotificationGroup := false
 	channelParam.AddToDefaultNotificationGroup = &addToDefaultNotificationGroup
-	channel, err := client.CreateChannel(&channelParam)
+	channel, err := client.CreateChannelContext(ctx, &channelParam)
 	if err != nil {
 		return err
 	}
@@ -81,7 +86,6 @@ func (m *ChannelModel) createInner(_ context.Context, client channelCreator) err
 }
 
 // Reads a channel.
-// Currently this function is NOT cancelable.
 func (m *ChannelModel) Read(ctx context.Context, client *Client) error {
 	newModel, err := ReadChannel(ctx, client, m.ID.ValueString())
 	if err != nil {
@@ -94,7 +98,9 @@ func (m *ChannelModel) Read(ctx context.Context, client *Client) error {
 
 // Updates a channel.
 func (m *ChannelModel) Update(ctx context.Context, client *Client) error {
-	return m.updateInner(ctx, client)
+	param := *m
+	param.Name = client.prefixName(m.Name)
+	return param.updateInner(ctx, client)
 }
 
 type channelUpdater interface {
@@ -110,8 +116,8 @@ func (m *ChannelModel) updateInner(ctx context.Context, client channelUpdater) e
 }
 
 // Deletes a channel.
-func (m *ChannelModel) Delete(_ context.Context, client *Client) error {
-	if _, err := client.DeleteChannel(m.ID.ValueString()); err != nil {
+func (m *ChannelModel) Delete(ctx context.Context, client *Client) error {
+	if _, err := client.DeleteChannelContext(ctx, m.ID.ValueString()); err != nil {
 		return err
 	}
 	return nil
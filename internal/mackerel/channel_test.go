@@ -194,7 +194,7 @@ type channelCreatorTester struct {
 	Request mackerel.Channel
 }
 
-func (ct *channelCreatorTester) CreateChannel(param *mackerel.Channel) (*mackerel.Channel, error) {
+func (ct *channelCreatorTester) CreateChannelContext(_ context.Context, param *mackerel.Channel) (*mackerel.Channel, error) {
 	ct.Request = *param
 	data := *param
 	data.ID = ct.ID
@@ -0,0 +1,177 @@
+package mackerel
+
+import (
+	"context"
+	"slices"
+
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+// Client is a Mackerel API client shared by all resources and data sources of the provider.
+//
+// Collections which can only be looked up by listing (e.g. channels) are cached for the run,
+// so that refreshing many objects does not call the same list API repeatedly.
+// Writes through this client invalidate the affected collections.
+// The cache is effective only through the context-aware methods (e.g. FindChannelsContext).
+type Client struct {
+	*mackerel.Client
+
+	cache *listCache
+	// The organization never changes during a run, so it is cached apart from the collections.
+	orgCache *listCache
+
+	// Written to the footer of memos, to tell the objects managed by Terraform. See memo.go.
+	managedMarker string
+	// Prepended to the names of objects. See name_prefix.go.
+	namePrefix string
+}
+
+func newClient(client *mackerel.Client) *Client {
+	return &Client{
+		Client:   client,
+		cache:    newListCache(),
+		orgCache: newListCache(),
+	}
+}
+
+// #region channels
+
+func (c *Client) FindChannelsContext(ctx context.Context) ([]*mackerel.Channel, error) {
+	return cachedList(ctx, c.cache, cacheKeyChannels, c.Client.FindChannelsContext)
+}
+
+func (c *Client) CreateChannelContext(ctx context.Context, param *mackerel.Channel) (*mackerel.Channel, error) {
+	defer c.cache.invalidate(cacheKeyChannels, cacheKeyNotificationGroups)
+	return c.Client.CreateChannelContext(ctx, param)
+}
+
+func (c *Client) UpdateChannelContext(ctx context.Context, id string, param *mackerel.Channel) (*mackerel.Channel, error) {
+	defer c.cache.invalidate(cacheKeyChannels)
+	return c.Client.UpdateChannelContext(ctx, id, param)
+}
+
+func (c *Client) DeleteChannelContext(ctx context.Context, id string) (*mackerel.Channel, error) {
+	defer c.cache.invalidateAll()
+	return c.Client.DeleteChannelContext(ctx, id)
+}
+
+// #endregion
+
+// #region downtimes
+
+func (c *Client) FindDowntimesContext(ctx context.Context) ([]*mackerel.Downtime, error) {
+	return cachedList(ctx, c.cache, cacheKeyDowntimes, c.Client.FindDowntimesContext)
+}
+
+func (c *Client) CreateDowntimeContext(ctx context.Context, param *mackerel.Downtime) (*mackerel.Downtime, error) {
+	defer c.cache.invalidate(cacheKeyDowntimes)
+	return c.Client.CreateDowntimeContext(ctx, param)
+}
+
+func (c *Client) UpdateDowntimeContext(ctx context.Context, id string, param *mackerel.Downtime) (*mackerel.Downtime, error) {
+	defer c.cache.invalidate(cacheKeyDowntimes)
+	return c.Client.UpdateDowntimeContext(ctx, id, param)
+}
+
+func (c *Client) DeleteDowntimeContext(ctx context.Context, id string) (*mackerel.Downtime, error) {
+	defer c.cache.invalidate(cacheKeyDowntimes)
+	return c.Client.DeleteDowntimeContext(ctx, id)
+}
+
+// #endregion
+
+// #region monitors
+
+// Prefetches all monitors at once, so that refreshing many monitors calls the list API only once.
+func (c *Client) FindMonitorsContext(ctx context.Context) ([]mackerel.Monitor, error) {
+	return cachedList(ctx, c.cache, cacheKeyMonitors, c.Client.FindMonitorsContext)
+}
+
+// Gets a monitor from the prefetched monitors.
+// Monitors created after the prefetch are fetched individually.
+func (c *Client) GetMonitorContext(ctx context.Context, id string) (mackerel.Monitor, error) {
+	monitors, err := c.FindMonitorsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if idx := slices.IndexFunc(monitors, func(m mackerel.Monitor) bool {
+		return m.MonitorID() == id
+	}); idx >= 0 {
+		return monitors[idx], nil
+	}
+	return c.Client.GetMonitorContext(ctx, id)
+}
+
+func (c *Client) CreateMonitorContext(ctx context.Context, param mackerel.Monitor) (mackerel.Monitor, error) {
+	defer c.cache.invalidate(cacheKeyMonitors)
+	return c.Client.CreateMonitorContext(ctx, param)
+}
+
+func (c *Client) UpdateMonitorContext(ctx context.Context, id string, param mackerel.Monitor) (mackerel.Monitor, error) {
+	defer c.cache.invalidate(cacheKeyMonitors)
+	return c.Client.UpdateMonitorContext(ctx, id, param)
+}
+
+func (c *Client) DeleteMonitorContext(ctx context.Context, id string) (mackerel.Monitor, error) {
+	defer c.cache.invalidateAll()
+	return c.Client.DeleteMonitorContext(ctx, id)
+}
+
+// #endregion
+
+// #region notification groups
+
+func (c *Client) FindNotificationGroupsContext(ctx context.Context) ([]*mackerel.NotificationGroup, error) {
+	return cachedList(ctx, c.cache, cacheKeyNotificationGroups, c.Client.FindNotificationGroupsContext)
+}
+
+func (c *Client) CreateNotificationGroupContext(ctx context.Context, param *mackerel.NotificationGroup) (*mackerel.NotificationGroup, error) {
+	defer c.cache.invalidate(cacheKeyNotificationGroups)
+	return c.Client.CreateNotificationGroupContext(ctx, param)
+}
+
+func (c *Client) UpdateNotificationGroupContext(ctx context.Context, id string, param *mackerel.NotificationGroup) (*mackerel.NotificationGroup, error) {
+	defer c.cache.invalidate(cacheKeyNotificationGroups)
+	return c.Client.UpdateNotificationGroupContext(ctx, id, param)
+}
+
+func (c *Client) DeleteNotificationGroupContext(ctx context.Context, id string) (*mackerel.NotificationGroup, error) {
+	defer c.cache.invalidate(cacheKeyNotificationGroups)
+	return c.Client.DeleteNotificationGroupContext(ctx, id)
+}
+
+// #endregion
+
+// #region services and roles
+
+func (c *Client) FindServicesContext(ctx context.Context) ([]*mackerel.Service, error) {
+	return cachedList(ctx, c.cache, cacheKeyServices, c.Client.FindServicesContext)
+}
+
+func (c *Client) CreateServiceContext(ctx context.Context, param *mackerel.CreateServiceParam) (*mackerel.Service, error) {
+	defer c.cache.invalidate(cacheKeyServices)
+	return c.Client.CreateServiceContext(ctx, param)
+}
+
+func (c *Client) DeleteServiceContext(ctx context.Context, name string) (*mackerel.Service, error) {
+	defer c.cache.invalidateAll()
+	return c.Client.DeleteServiceContext(ctx, name)
+}
+
+func (c *Client) FindRolesContext(ctx context.Context, serviceName string) ([]*mackerel.Role, error) {
+	return cachedList(ctx, c.cache, cacheKeyRoles(serviceName), func(ctx context.Context) ([]*mackerel.Role, error) {
+		return c.Client.FindRolesContext(ctx, serviceName)
+	})
+}
+
+func (c *Client) CreateRoleContext(ctx context.Context, serviceName string, param *mackerel.CreateRoleParam) (*mackerel.Role, error) {
+	defer c.cache.invalidate(cacheKeyRoles(serviceName), cacheKeyServices)
+	return c.Client.CreateRoleContext(ctx, serviceName, param)
+}
+
+func (c *Client) DeleteRoleContext(ctx context.Context, serviceName, roleName string) (*mackerel.Role, error) {
+	defer c.cache.invalidateAll()
+	return c.Client.DeleteRoleContext(ctx, serviceName, roleName)
+}
+
+// #endregion
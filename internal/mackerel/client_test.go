@@ -0,0 +1,100 @@
+package mackerel
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	t.Helper()
+
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+
+	c, err := mackerel.NewClientWithOptions("api-key", ts.URL, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newClient(c)
+}
+
+func Test_Client_cacheChannels(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	requests := map[string]int{}
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.Method+" "+r.URL.Path]++
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"channels":[{"id":"ch0","name":"ch0","type":"webhook","url":"https://example.test/","events":[]}]}`)
+		case http.MethodPost:
+			fmt.Fprint(w, `{"id":"ch1","name":"ch1","type":"webhook","url":"https://example.test/","events":[]}`)
+		}
+	}))
+
+	for range 3 {
+		if _, err := client.FindChannelsContext(t.Context()); err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+	}
+	if _, err := client.CreateChannelContext(t.Context(), &mackerel.Channel{Name: "ch1", Type: "webhook"}); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if _, err := client.FindChannelsContext(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if got := requests["GET /api/v0/channels"]; got != 2 {
+		t.Errorf("expected to list channels twice, but got %d times", got)
+	}
+}
+
+func Test_Client_prefetchMonitors(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	requests := map[string]int{}
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.URL.Path]++
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v0/monitors":
+			fmt.Fprint(w, `{"monitors":[{"id":"m0","type":"connectivity","name":"m0"},{"id":"m1","type":"connectivity","name":"m1"}]}`)
+		case "/api/v0/monitors/m2":
+			fmt.Fprint(w, `{"monitor":{"id":"m2","type":"connectivity","name":"m2"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error":{"message":"Monitor Not Found."}}`)
+		}
+	}))
+
+	for _, id := range []string{"m0", "m1", "m2"} {
+		m, err := client.GetMonitorContext(t.Context(), id)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if m.MonitorID() != id {
+			t.Errorf("expected %s, but got %s", id, m.MonitorID())
+		}
+	}
+	if _, err := ReadMonitor(t.Context(), client, "m3"); !IsNotFound(err) {
+		t.Errorf("expected a not found error, but got: %+v", err)
+	}
+
+	if got := requests["/api/v0/monitors"]; got != 1 {
+		t.Errorf("expected to list monitors once, but got %d times", got)
+	}
+}
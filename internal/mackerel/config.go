@@ -2,19 +2,48 @@ package mackerel
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/mackerelio/mackerel-client-go"
 )
 
-type Client = mackerel.Client
-
 type ClientConfigModel struct {
-	APIKey  types.String `tfsdk:"api_key"`
-	APIBase types.String `tfsdk:"api_base"`
+	APIKey                  types.String `tfsdk:"api_key"`
+	APIKeyFile              types.String `tfsdk:"api_key_file"`
+	APIKeyCommand           types.List   `tfsdk:"api_key_command"`
+	MackerelAgentConfigPath types.String `tfsdk:"mackerel_agent_config_path"`
+	ReadAPIKey              types.String `tfsdk:"read_api_key"`
+	WriteAPIKey             types.String `tfsdk:"write_api_key"`
+
+	APIBase      types.String `tfsdk:"api_base"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin types.Int64  `tfsdk:"retry_wait_min"`
+	RetryWaitMax types.Int64  `tfsdk:"retry_wait_max"`
+
+	RequestsPerMinute types.Int64 `tfsdk:"requests_per_minute"`
+
+	ExpectedOrganization types.String `tfsdk:"expected_organization"`
+
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ExtraHeaders       types.Map    `tfsdk:"extra_headers"`
+
+	ManagedMarker types.String `tfsdk:"managed_marker"`
+	NamePrefix    types.String `tfsdk:"name_prefix"`
+
+	// Sent as User-Agent if not empty. It is not configurable by users.
+	UserAgent string `tfsdk:"-"`
+
+	// Where APIKey comes from. It is set by NewClientConfigFromEnv or ResolveAPIKey.
+	apiKeySource string
+	// The sources whose API keys are overridden by others. See MergeAPIKeySources.
+	ignoredAPIKeySources []string
 }
 
 var (
@@ -28,6 +57,9 @@ func NewClientConfigFromEnv() ClientConfigModel {
 	for _, env := range []string{"MACKEREL_APIKEY", "MACKEREL_API_KEY"} {
 		if apiKey == "" {
 			apiKey = os.Getenv(env)
+			if apiKey != "" {
+				data.apiKeySource = env + " environment variable"
+			}
 		}
 	}
 	if apiKey != "" {
@@ -42,9 +74,43 @@ func NewClientConfigFromEnv() ClientConfigModel {
 	return data
 }
 
+// Merges the API key sources in the provider block into the config from the environment variables.
+// The environment variables keep taking precedence over api_key as they did before the other sources were added,
+// but they are ignored if api_key_file, api_key_command or mackerel_agent_config_path is configured.
+// read_api_key and write_api_key do not override the environment variables, which remain the fallback of them.
+func (m *ClientConfigModel) MergeAPIKeySources(block ClientConfigModel) {
+	m.ReadAPIKey = block.ReadAPIKey
+	m.WriteAPIKey = block.WriteAPIKey
+	m.APIKeyFile = block.APIKeyFile
+	m.APIKeyCommand = block.APIKeyCommand
+	m.MackerelAgentConfigPath = block.MackerelAgentConfigPath
+
+	if block.APIKeyFile.IsNull() && block.APIKeyCommand.IsNull() && block.MackerelAgentConfigPath.IsNull() {
+		if m.APIKey.IsNull() {
+			m.APIKey = block.APIKey
+		} else if !block.APIKey.IsNull() {
+			m.ignoredAPIKeySources = append(m.ignoredAPIKeySources, "api_key")
+		}
+		return
+	}
+	if !m.APIKey.IsNull() {
+		m.ignoredAPIKeySources = append(m.ignoredAPIKeySources, m.apiKeySource)
+	}
+	if !block.APIKey.IsNull() {
+		m.ignoredAPIKeySources = append(m.ignoredAPIKeySources, "api_key")
+	}
+	m.APIKey = types.StringNull()
+	m.apiKeySource = ""
+}
+
+// Returns the sources whose API keys are ignored because a source of higher precedence is configured.
+func (m *ClientConfigModel) IgnoredAPIKeySources() []string {
+	return m.ignoredAPIKeySources
+}
+
 func (m *ClientConfigModel) NewClient() (*Client, error) {
-	apiKey := m.APIKey.ValueString()
-	if apiKey == "" {
+	readKey, writeKey := m.scopedAPIKeys()
+	if readKey == "" {
 		return nil, ErrNoAPIKey
 	}
 
@@ -52,15 +118,86 @@ func (m *ClientConfigModel) NewClient() (*Client, error) {
 
 	var client *mackerel.Client
 	if apiBase == "" {
-		client = mackerel.NewClient(apiKey)
+		client = mackerel.NewClient(readKey)
 	} else {
-		// TODO: use logging transport with tflog (FYI: https://github.com/hashicorp/terraform-plugin-log/issues/91)
-		c, err := mackerel.NewClientWithOptions(apiKey, apiBase, false)
+		c, err := mackerel.NewClientWithOptions(readKey, apiBase, false)
 		if err != nil {
 			return nil, err
 		}
 		client = c
 	}
-	client.HTTPClient.Transport = logging.NewSubsystemLoggingHTTPTransport("Mackerel", http.DefaultTransport)
-	return client, nil
+
+	if m.UserAgent != "" {
+		client.UserAgent = m.UserAgent
+	}
+
+	base, err := m.newBaseTransport()
+	if err != nil {
+		return nil, err
+	}
+	var transport http.RoundTripper = base
+	if header, err := m.extraHeaders(); err != nil {
+		return nil, err
+	} else if header != nil {
+		transport = &headerTransport{base: transport, header: header}
+	}
+	transport = &loggingTransport{base: transport, apiKeys: []string{readKey, writeKey}}
+	transport = &timeoutTransport{base: transport, timeout: apiRequestTimeout}
+	if limiter, err := m.newRateLimiter(); err != nil {
+		return nil, err
+	} else if limiter != nil {
+		transport = &rateLimitTransport{base: transport, limiter: limiter}
+	}
+	transport, err = m.newRetryTransport(transport)
+	if err != nil {
+		return nil, err
+	}
+	c := newClient(client)
+	c.managedMarker = m.ManagedMarker.ValueString()
+	c.namePrefix = m.NamePrefix.ValueString()
+	// The timeout is applied to each attempt by timeoutTransport.
+	client.HTTPClient.Timeout = 0
+	transport = &writeLogTransport{base: transport, orgName: c.OrgName}
+	if writeKey != readKey {
+		transport = &apiKeyTransport{base: transport, readKey: readKey, writeKey: writeKey}
+	}
+	client.HTTPClient.Transport = transport
+	return c, nil
+}
+
+func (m *ClientConfigModel) newRetryTransport(base http.RoundTripper) (*retryTransport, error) {
+	t := &retryTransport{
+		base:       base,
+		maxRetries: defaultMaxRetries,
+		waitMin:    defaultRetryWaitMin,
+		waitMax:    defaultRetryWaitMax,
+	}
+	if !m.MaxRetries.IsNull() && !m.MaxRetries.IsUnknown() {
+		t.maxRetries = int(m.MaxRetries.ValueInt64())
+	}
+	if !m.RetryWaitMin.IsNull() && !m.RetryWaitMin.IsUnknown() {
+		t.waitMin = time.Duration(m.RetryWaitMin.ValueInt64()) * time.Second
+	}
+	if !m.RetryWaitMax.IsNull() && !m.RetryWaitMax.IsUnknown() {
+		t.waitMax = time.Duration(m.RetryWaitMax.ValueInt64()) * time.Second
+	}
+	if t.maxRetries < 0 {
+		return nil, fmt.Errorf("max_retries must not be negative, but got: %d", t.maxRetries)
+	}
+	if t.waitMin > t.waitMax {
+		return nil, fmt.Errorf("retry_wait_min (%s) must not be greater than retry_wait_max (%s)", t.waitMin, t.waitMax)
+	}
+	return t, nil
+}
+
+// Returns nil if requests are not limited.
+func (m *ClientConfigModel) newRateLimiter() (*rateLimiter, error) {
+	if m.RequestsPerMinute.IsNull() || m.RequestsPerMinute.IsUnknown() {
+		return nil, nil
+	}
+	rpm := m.RequestsPerMinute.ValueInt64()
+	if rpm <= 0 {
+		return nil, fmt.Errorf("requests_per_minute must be positive, but got: %d", rpm)
+	}
+	return newRateLimiter(int(rpm)), nil
 }
@@ -17,6 +17,8 @@ type (
 		URLPath   types.String `tfsdk:"url_path"`
 		CreatedAt types.Int64  `tfsdk:"created_at"`
 		UpdatedAt types.Int64  `tfsdk:"updated_at"`
+		// Stored in the footer of the memo.
+		Labels map[string]string `tfsdk:"labels"`
 
 		Graph       []DashboardWidgetGraph       `tfsdk:"graph"`
 		Value       []DashboardWidgetValue       `tfsdk:"value"`
@@ -118,17 +120,46 @@ type (
 	}
 )
 
-func ReadDashboard(_ context.Context, client *Client, id string) (DashboardModel, error) {
-	d, err := client.FindDashboard(id)
+func ReadDashboard(ctx context.Context, client *Client, id string) (DashboardModel, error) {
+	d, err := client.FindDashboardContext(ctx, id)
+	if err != nil {
+		return DashboardModel{}, wrapNotFound(err)
+	}
+	model, err := newDashboard(*d)
+	if err != nil {
+		return DashboardModel{}, err
+	}
+	model.Title = client.trimNamePrefix(model.Title)
+	return model, nil
+}
+
+// Finds the only dashboard which has all of the labels.
+func FindDashboardByLabels(ctx context.Context, client *Client, labels map[string]string) (DashboardModel, error) {
+	mds, err := client.FindDashboardsContext(ctx)
+	if err != nil {
+		return DashboardModel{}, err
+	}
+	// Dashboards in the list have no widgets.
+	models := make([]DashboardModel, 0, len(mds))
+	for _, md := range mds {
+		model, err := newDashboard(*md)
+		if err != nil {
+			continue
+		}
+		models = append(models, model)
+	}
+	d, err := findByLabels("dashboard", models, func(d DashboardModel) map[string]string { return d.Labels }, labels)
 	if err != nil {
 		return DashboardModel{}, err
 	}
-	return newDashboard(*d)
+	return ReadDashboard(ctx, client, d.ID.ValueString())
 }
 
-func (d *DashboardModel) Create(_ context.Context, client *Client) error {
+func (d *DashboardModel) Create(ctx context.Context, client *Client) error {
 	param := d.mackerelDashboard()
-	md, err := client.CreateDashboard(&param)
+	param.Title = client.prefixName(d.Title).ValueString()
+	param.Memo = joinMemo(param.Memo, client.managedMarker, d.Labels)
+	md, err := client.CreateDashboardContext(ctx, &param)
 	if err != nil {
 		return err
 	}
@@ -147,9 +178,11 @@ func (d *DashboardModel) Read(ctx context.Context, client *Client) error {
 	return nil
 }
 
-func (d *DashboardModel) Update(_ context.Context, client *Client) error {
+func (d *DashboardModel) Update(ctx context.Context, client *Client) error {
 	param := d.mackerelDashboard()
-	md, err := client.UpdateDashboard(d.ID.ValueString(), &param)
+	param.Title = client.prefixName(d.Title).ValueString()
+	param.Memo = joinMemo(param.Memo, client.managedMarker, d.Labels)
+	md, err := client.UpdateDashboardContext(ctx, d.ID.ValueString(), &param)
 	if err != nil {
 		return err
 	}
@@ -157,8 +190,8 @@ func (d *DashboardModel) Update(_ context.Context, client *Client) error {
 	return nil
 }
 
-func (d DashboardModel) Delete(_ context.Context, client *Client) error {
-	if _, err := client.DeleteDashboard(d.ID.ValueString()); err != nil {
+func (d DashboardModel) Delete(ctx context.Context, client *Client) error {
+	if _, err := client.DeleteDashboardContext(ctx, d.ID.ValueString()); err != nil {
 		return err
 	}
 	return nil
@@ -199,6 +232,7 @@ func newDashboard(d mackerel.Dashboard) (DashboardModel, error) {
 		Markdown:    []DashboardWidgetMarkdown{},
 		AlertStatus: []DashboardWidgetAlertStatus{},
 	}
+	m.Memo, m.Labels = splitMemoValue(m.Memo)
 
 	for _, w := range d.Widgets {
 		// unsupported features
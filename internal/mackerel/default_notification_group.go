@@ -23,8 +23,8 @@ func readDefaultNotificationGroupInner(ctx context.Context, client notificationG
 	return newDefaultNotificationGroupModel(*ng), nil
 }
 
-func findDefaultNotificationGroup(_ context.Context, client notificationGroupFinder) (*mackerel.NotificationGroup, error) {
-	ngs, err := client.FindNotificationGroups()
+func findDefaultNotificationGroup(ctx context.Context, client notificationGroupFinder) (*mackerel.NotificationGroup, error) {
+	ngs, err := client.FindNotificationGroupsContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +60,7 @@ func (m *DefaultNotificationGroupModel) Read(ctx context.Context, client *Client
 
 type defaultNotificationGroupUpdater interface {
 	notificationGroupFinder
-	UpdateNotificationGroup(string, *mackerel.NotificationGroup) (*mackerel.NotificationGroup, error)
+	UpdateNotificationGroupContext(context.Context, string, *mackerel.NotificationGroup) (*mackerel.NotificationGroup, error)
 }
 
 func (m *DefaultNotificationGroupModel) Update(ctx context.Context, client *Client) error {
@@ -85,7 +85,7 @@ func (m *DefaultNotificationGroupModel) updateInner(ctx context.Context, client
 		param.ChildChannelIDs = append(param.ChildChannelIDs, id.ValueString())
 	}
 
-	updated, err := client.UpdateNotificationGroup(ng.ID, &param)
+	updated, err := client.UpdateNotificationGroupContext(ctx, ng.ID, &param)
 	if err != nil {
 		return err
 	}
@@ -167,11 +167,11 @@ type defaultNotificationGroupUpdaterTester struct {
 	Request mackerel.NotificationGroup
 }
 
-func (ut *defaultNotificationGroupUpdaterTester) FindNotificationGroups() ([]*mackerel.NotificationGroup, error) {
+func (ut *defaultNotificationGroupUpdaterTester) FindNotificationGroupsContext(_ context.Context) ([]*mackerel.NotificationGroup, error) {
 	return ut.Groups, nil
 }
 
-func (ut *defaultNotificationGroupUpdaterTester) UpdateNotificationGroup(id string, param *mackerel.NotificationGroup) (*mackerel.NotificationGroup, error) {
+func (ut *defaultNotificationGroupUpdaterTester) UpdateNotificationGroupContext(_ context.Context, id string, param *mackerel.NotificationGroup) (*mackerel.NotificationGroup, error) {
 	ut.ID = id
 	ut.Request = *param
 	data := *param
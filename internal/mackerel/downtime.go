@@ -23,6 +23,8 @@ type DowntimeModel struct {
 	RoleExcludeScopes    []string             `tfsdk:"role_exclude_scopes"`
 	MonitorScopes        []string             `tfsdk:"monitor_scopes"`
 	MonitorExcludeScopes []string             `tfsdk:"monitor_exclude_scopes"`
+	// Stored in the footer of the memo.
+	Labels map[string]string `tfsdk:"labels"`
 }
 type DowntimeRecurrence struct {
 	Type     types.String `tfsdk:"type"`
@@ -31,16 +33,21 @@ type DowntimeRecurrence struct {
 	Until    types.Int64  `tfsdk:"until"`
 }
 
-func ReadDowntime(_ context.Context, client *Client, id string) (*DowntimeModel, error) {
-	return readDowntime(client, id)
+func ReadDowntime(ctx context.Context, client *Client, id string) (*DowntimeModel, error) {
+	model, err := readDowntime(ctx, client, id)
+	if err != nil {
+		return nil, err
+	}
+	model.Name = client.trimNamePrefix(model.Name)
+	return model, nil
 }
 
 type downtimeFinder interface {
-	FindDowntimes() ([]*mackerel.Downtime, error)
+	FindDowntimesContext(context.Context) ([]*mackerel.Downtime, error)
 }
 
-func readDowntime(client downtimeFinder, id string) (*DowntimeModel, error) {
-	downtimes, err := client.FindDowntimes()
+func readDowntime(ctx context.Context, client downtimeFinder, id string) (*DowntimeModel, error) {
+	downtimes, err := client.FindDowntimesContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -49,14 +56,39 @@ func readDowntime(client downtimeFinder, id string) (*DowntimeModel, error) {
 		return d.ID == id
 	})
 	if downtimeIdx < 0 {
-		return nil, fmt.Errorf("the ID '%s' does not match any downtime in mackerel.io", id)
+		return nil, newNotFoundError("the ID '%s' does not match any downtime in mackerel.io", id)
 	}
 
 	return newDowntime(*downtimes[downtimeIdx]), nil
 }
 
-func (d *DowntimeModel) Create(_ context.Context, client *Client) error {
-	createdDowntime, err := client.CreateDowntime(d.mackerelDowntime())
+// Finds the only downtime which has all of the labels.
+func FindDowntimeByLabels(ctx context.Context, client *Client, labels map[string]string) (*DowntimeModel, error) {
+	model, err := findDowntimeByLabels(ctx, client, labels)
+	if err != nil {
+		return nil, err
+	}
+	model.Name = client.trimNamePrefix(model.Name)
+	return model, nil
+}
+
+func findDowntimeByLabels(ctx context.Context, client downtimeFinder, labels map[string]string) (*DowntimeModel, error) {
+	downtimes, err := client.FindDowntimesContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	models := make([]*DowntimeModel, 0, len(downtimes))
+	for _, d := range downtimes {
+		models = append(models, newDowntime(*d))
+	}
+	return findByLabels("downtime", models, func(d *DowntimeModel) map[string]string { return d.Labels }, labels)
+}
+
+func (d *DowntimeModel) Create(ctx context.Context, client *Client) error {
+	param := d.mackerelDowntime()
+	param.Name = client.prefixName(d.Name).ValueString()
+	param.Memo = joinMemo(param.Memo, client.managedMarker, d.Labels)
+	createdDowntime, err := client.CreateDowntimeContext(ctx, param)
 	if err != nil {
 		return err
 	}
@@ -65,8 +97,8 @@ func (d *DowntimeModel) Create(_ context.Context, client *Client) error {
 	return nil
 }
 
-func (d *DowntimeModel) Read(_ context.Context, client *Client) error {
-	newModel, err := readDowntime(client, d.ID.ValueString())
+func (d *DowntimeModel) Read(ctx context.Context, client *Client) error {
+	newModel, err := ReadDowntime(ctx, client, d.ID.ValueString())
 	if err != nil {
 		return err
 	}
@@ -74,15 +106,18 @@ func (d *DowntimeModel) Read(_ context.Context, client *Client) error {
 	return nil
 }
 
-func (d *DowntimeModel) Update(_ context.Context, client *Client) error {
-	if _, err := client.UpdateDowntime(d.ID.ValueString(), d.mackerelDowntime()); err != nil {
+func (d *DowntimeModel) Update(ctx context.Context, client *Client) error {
+	param := d.mackerelDowntime()
+	param.Name = client.prefixName(d.Name).ValueString()
+	param.Memo = joinMemo(param.Memo, client.managedMarker, d.Labels)
+	if _, err := client.UpdateDowntimeContext(ctx, d.ID.ValueString(), param); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (d *DowntimeModel) Delete(_ context.Context, client *Client) error {
-	if _, err := client.DeleteDowntime(d.ID.ValueString()); err != nil {
+func (d *DowntimeModel) Delete(ctx context.Context, client *Client) error {
+	if _, err := client.DeleteDowntimeContext(ctx, d.ID.ValueString()); err != nil {
 		return err
 	}
 	return nil
@@ -102,6 +137,7 @@ func newDowntime(d mackerel.Downtime) *DowntimeModel {
 		MonitorScopes:        nilAsEmptySlice(d.MonitorScopes),
 		MonitorExcludeScopes: nilAsEmptySlice(d.MonitorExcludeScopes),
 	}
+	model.Memo, model.Labels = splitMemoValue(model.Memo)
 	if d.Recurrence != nil {
 		recurrence := DowntimeRecurrence{
 			Type:     types.StringValue(d.Recurrence.Type.String()),
@@ -1,6 +1,7 @@
 package mackerel
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -82,7 +83,7 @@ func Test_Downtime_ReadDowntime(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			model, err := readDowntime(tt.inClient, tt.inID)
+			model, err := readDowntime(context.Background(), tt.inClient, tt.inID)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("unexpected error: %+v", err)
 			}
@@ -96,9 +97,45 @@ func Test_Downtime_ReadDowntime(t *testing.T) {
 	}
 }
 
+func Test_Downtime_findDowntimeByLabels(t *testing.T) {
+	t.Parallel()
+
+	client := downtimeFinderFunc(func() ([]*mackerel.Downtime, error) {
+		return []*mackerel.Downtime{
+			{ID: "5ghjb6vgDFN", Name: "unlabeled"},
+			{ID: "5ghjbbVABY5", Name: "labeled", Memo: "memo\n\n" + `[terraform-provider-mackerel] {"labels":{"env":"production"}}`},
+		}, nil
+	})
+
+	model, err := findDowntimeByLabels(context.Background(), client, map[string]string{"env": "production"})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if diff := cmp.Diff(DowntimeModel{
+		ID:                   types.StringValue("5ghjbbVABY5"),
+		Name:                 types.StringValue("labeled"),
+		Memo:                 types.StringValue("memo"),
+		Start:                types.Int64Value(0),
+		Duration:             types.Int64Value(0),
+		ServiceScopes:        []string{},
+		ServiceExcludeScopes: []string{},
+		RoleScopes:           []string{},
+		RoleExcludeScopes:    []string{},
+		MonitorScopes:        []string{},
+		MonitorExcludeScopes: []string{},
+		Labels:               map[string]string{"env": "production"},
+	}, *model); diff != "" {
+		t.Error(diff)
+	}
+
+	if _, err := findDowntimeByLabels(context.Background(), client, map[string]string{"env": "staging"}); err == nil {
+		t.Error("expected an error, but got nil")
+	}
+}
+
 type downtimeFinderFunc func() ([]*mackerel.Downtime, error)
 
-func (f downtimeFinderFunc) FindDowntimes() ([]*mackerel.Downtime, error) {
+func (f downtimeFinderFunc) FindDowntimesContext(_ context.Context) ([]*mackerel.Downtime, error) {
 	return f()
 }
 
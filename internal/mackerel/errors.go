@@ -0,0 +1,46 @@
+package mackerel
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+// NotFoundError reports that the requested object does not exist in mackerel.io.
+// Resources are expected to remove themselves from the state on this error.
+type NotFoundError struct {
+	msg string
+	err error
+}
+
+func (e *NotFoundError) Error() string {
+	if e.msg != "" {
+		return e.msg
+	}
+	return e.err.Error()
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return e.err
+}
+
+func newNotFoundError(format string, args ...any) error {
+	return &NotFoundError{msg: fmt.Sprintf(format, args...)}
+}
+
+// Wraps an error into NotFoundError if it is a 404 response from Mackerel API.
+func wrapNotFound(err error) error {
+	var apiErr *mackerel.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		return &NotFoundError{err: err}
+	}
+	return err
+}
+
+// Reports whether the error means that the object does not exist.
+func IsNotFound(err error) bool {
+	var nfErr *NotFoundError
+	return errors.As(err, &nfErr)
+}
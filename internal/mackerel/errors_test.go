@@ -0,0 +1,91 @@
+package mackerel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+func Test_IsNotFound(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		in   error
+		want bool
+	}{
+		"nil": {
+			in:   nil,
+			want: false,
+		},
+		"generic error": {
+			in:   fmt.Errorf("something went wrong"),
+			want: false,
+		},
+		"not found": {
+			in:   newNotFoundError("the ID '%s' does not match any channel in mackerel.io", "xxx"),
+			want: true,
+		},
+		"wrapped not found": {
+			in:   fmt.Errorf("failed to read: %w", newNotFoundError("not found")),
+			want: true,
+		},
+		"404": {
+			in:   wrapNotFound(&mackerel.APIError{StatusCode: http.StatusNotFound, Message: "Monitor Not Found."}),
+			want: true,
+		},
+		"500": {
+			in:   wrapNotFound(&mackerel.APIError{StatusCode: http.StatusInternalServerError, Message: "Internal Server Error"}),
+			want: false,
+		},
+		"unwrapped 404": {
+			in:   &mackerel.APIError{StatusCode: http.StatusNotFound, Message: "Monitor Not Found."},
+			want: false,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := IsNotFound(tt.in); got != tt.want {
+				t.Errorf("expected %t, but got %t", tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_NotFoundError_message(t *testing.T) {
+	t.Parallel()
+
+	apiErr := &mackerel.APIError{StatusCode: http.StatusNotFound, Message: "Monitor Not Found."}
+	if got, want := wrapNotFound(apiErr).Error(), apiErr.Error(); got != want {
+		t.Errorf("expected '%s', but got '%s'", want, got)
+	}
+
+	if got, want := newNotFoundError("the ID '%s' does not match", "xxx").Error(), "the ID 'xxx' does not match"; got != want {
+		t.Errorf("expected '%s', but got '%s'", want, got)
+	}
+}
+
+func Test_readRoleInner_notFound(t *testing.T) {
+	t.Parallel()
+
+	client := roleFinderFunc(func(string) ([]*mackerel.Role, error) {
+		return []*mackerel.Role{{Name: "role0"}}, nil
+	})
+	_, err := readRoleInner(context.Background(), client, "service0", "role-not-exists")
+	if !IsNotFound(err) {
+		t.Errorf("expected a not found error, but got: %+v", err)
+	}
+
+	noService := roleFinderFunc(func(string) ([]*mackerel.Role, error) {
+		return nil, &mackerel.APIError{StatusCode: http.StatusNotFound, Message: "Service Not Found"}
+	})
+	_, err = readRoleInner(context.Background(), noService, "service-not-exists", "role0")
+	if !IsNotFound(err) {
+		t.Errorf("expected a not found error, but got: %+v", err)
+	}
+}
@@ -0,0 +1,133 @@
+package mackerel
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/typeutil"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+type GraphAnnotationModel struct {
+	ID          types.String        `tfsdk:"id"`
+	Title       types.String        `tfsdk:"title"`
+	Description types.String        `tfsdk:"description"`
+	From        typeutil.TimeString `tfsdk:"from"`
+	To          typeutil.TimeString `tfsdk:"to"`
+	Service     types.String        `tfsdk:"service"`
+	Roles       []string            `tfsdk:"roles"`
+}
+
+// Reads a graph annotation. Mackerel API only finds annotations by the service and the period,
+// so the annotation is looked up in its own period.
+func ReadGraphAnnotation(ctx context.Context, client *Client, data GraphAnnotationModel) (GraphAnnotationModel, error) {
+	return readGraphAnnotationInner(ctx, client, data.ID.ValueString(), data.Service.ValueString(), data.From.ValueEpoch(), data.To.ValueEpoch())
+}
+
+// Imports a graph annotation by the ID, looking it up in all services.
+func ImportGraphAnnotation(ctx context.Context, client *Client, id string) (GraphAnnotationModel, error) {
+	return importGraphAnnotationInner(ctx, client, id, time.Now())
+}
+
+type graphAnnotationFinder interface {
+	FindGraphAnnotationsContext(ctx context.Context, service string, from, to int64) ([]*mackerel.GraphAnnotation, error)
+}
+
+func readGraphAnnotationInner(ctx context.Context, client graphAnnotationFinder, id, service string, from, to int64) (GraphAnnotationModel, error) {
+	annotations, err := client.FindGraphAnnotationsContext(ctx, service, from, to)
+	if err != nil {
+		return GraphAnnotationModel{}, wrapNotFound(err)
+	}
+	idx := slices.IndexFunc(annotations, func(a *mackerel.GraphAnnotation) bool {
+		return a.ID == id
+	})
+	if idx == -1 {
+		return GraphAnnotationModel{}, newNotFoundError("the graph annotation '%s' is not found in the service '%s'", id, service)
+	}
+	return newGraphAnnotation(*annotations[idx]), nil
+}
+
+type graphAnnotationImporter interface {
+	graphAnnotationFinder
+	FindServicesContext(context.Context) ([]*mackerel.Service, error)
+}
+
+func importGraphAnnotationInner(ctx context.Context, client graphAnnotationImporter, id string, now time.Time) (GraphAnnotationModel, error) {
+	services, err := client.FindServicesContext(ctx)
+	if err != nil {
+		return GraphAnnotationModel{}, err
+	}
+	for _, service := range services {
+		data, err := readGraphAnnotationInner(ctx, client, id, service.Name, 0, now.Unix())
+		if err == nil {
+			return data, nil
+		}
+		if !IsNotFound(err) {
+			return GraphAnnotationModel{}, err
+		}
+	}
+	return GraphAnnotationModel{}, newNotFoundError("the graph annotation '%s' is not found in any service", id)
+}
+
+// Creates a graph annotation.
+func (m *GraphAnnotationModel) Create(ctx context.Context, client *Client) error {
+	annotation, err := client.CreateGraphAnnotationContext(ctx, m.graphAnnotationParam())
+	if err != nil {
+		return err
+	}
+	m.ID = types.StringValue(annotation.ID)
+	return nil
+}
+
+// Reads a graph annotation.
+func (m *GraphAnnotationModel) Read(ctx context.Context, client *Client) error {
+	data, err := ReadGraphAnnotation(ctx, client, *m)
+	if err != nil {
+		return err
+	}
+	*m = data
+	return nil
+}
+
+// Updates a graph annotation.
+func (m *GraphAnnotationModel) Update(ctx context.Context, client *Client) error {
+	if _, err := client.UpdateGraphAnnotationContext(ctx, m.ID.ValueString(), m.graphAnnotationParam()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Deletes a graph annotation.
+func (m GraphAnnotationModel) Delete(ctx context.Context, client *Client) error {
+	if _, err := client.DeleteGraphAnnotationContext(ctx, m.ID.ValueString()); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m GraphAnnotationModel) graphAnnotationParam() *mackerel.GraphAnnotation {
+	return &mackerel.GraphAnnotation{
+		Title:       m.Title.ValueString(),
+		Description: m.Description.ValueString(),
+		From:        m.From.ValueEpoch(),
+		To:          m.To.ValueEpoch(),
+		Service:     m.Service.ValueString(),
+		Roles:       m.Roles,
+	}
+}
+
+func newGraphAnnotation(a mackerel.GraphAnnotation) GraphAnnotationModel {
+	roles := slices.Clone(a.Roles)
+	slices.Sort(roles)
+	return GraphAnnotationModel{
+		ID:          types.StringValue(a.ID),
+		Title:       types.StringValue(a.Title),
+		Description: types.StringValue(a.Description),
+		From:        typeutil.NewTimeStringEpochValue(a.From),
+		To:          typeutil.NewTimeStringEpochValue(a.To),
+		Service:     types.StringValue(a.Service),
+		Roles:       nilAsEmptySlice(roles),
+	}
+}
@@ -0,0 +1,161 @@
+package mackerel
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/typeutil"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+var testGraphAnnotations = map[string][]*mackerel.GraphAnnotation{
+	"service0": {
+		{
+			ID:      "annotation0",
+			Title:   "deploy",
+			From:    1700000000,
+			To:      1700000060,
+			Service: "service0",
+		},
+	},
+	"service1": {
+		{
+			ID:          "annotation1",
+			Title:       "migration",
+			Description: "add an index",
+			From:        1700000000,
+			To:          1700003600,
+			Service:     "service1",
+			Roles:       []string{"db", "app"},
+		},
+	},
+}
+
+func Test_ReadGraphAnnotation(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		inID      string
+		inService string
+
+		wants        GraphAnnotationModel
+		wantNotFound bool
+	}{
+		"basic": {
+			inID:      "annotation1",
+			inService: "service1",
+
+			wants: GraphAnnotationModel{
+				ID:          types.StringValue("annotation1"),
+				Title:       types.StringValue("migration"),
+				Description: types.StringValue("add an index"),
+				From:        typeutil.NewTimeStringValue("1700000000"),
+				To:          typeutil.NewTimeStringValue("1700003600"),
+				Service:     types.StringValue("service1"),
+				Roles:       []string{"app", "db"},
+			},
+		},
+		"no roles": {
+			inID:      "annotation0",
+			inService: "service0",
+
+			wants: GraphAnnotationModel{
+				ID:          types.StringValue("annotation0"),
+				Title:       types.StringValue("deploy"),
+				Description: types.StringValue(""),
+				From:        typeutil.NewTimeStringValue("1700000000"),
+				To:          typeutil.NewTimeStringValue("1700000060"),
+				Service:     types.StringValue("service0"),
+				Roles:       []string{},
+			},
+		},
+		"another service": {
+			inID:      "annotation1",
+			inService: "service0",
+
+			wantNotFound: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := readGraphAnnotationInner(context.Background(), graphAnnotationImporterMock{}, tt.inID, tt.inService, 1700000000, 1700000060)
+			if IsNotFound(err) != tt.wantNotFound {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.wants, data); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func Test_ImportGraphAnnotation(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		inID string
+
+		wantService  string
+		wantNotFound bool
+	}{
+		"first service": {
+			inID:        "annotation0",
+			wantService: "service0",
+		},
+		"second service": {
+			inID:        "annotation1",
+			wantService: "service1",
+		},
+		"not found": {
+			inID:         "annotation2",
+			wantNotFound: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := importGraphAnnotationInner(context.Background(), graphAnnotationImporterMock{}, tt.inID, time.Unix(1800000000, 0))
+			if IsNotFound(err) != tt.wantNotFound {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if err != nil {
+				return
+			}
+			if data.ID.ValueString() != tt.inID || data.Service.ValueString() != tt.wantService {
+				t.Errorf("unexpected annotation: %+v", data)
+			}
+		})
+	}
+}
+
+type graphAnnotationImporterMock struct{}
+
+func (graphAnnotationImporterMock) FindServicesContext(context.Context) ([]*mackerel.Service, error) {
+	return []*mackerel.Service{{Name: "service0"}, {Name: "service1"}}, nil
+}
+
+func (graphAnnotationImporterMock) FindGraphAnnotationsContext(_ context.Context, service string, from, to int64) ([]*mackerel.GraphAnnotation, error) {
+	annotations, ok := testGraphAnnotations[service]
+	if !ok {
+		return nil, fmt.Errorf("no such service: %s", service)
+	}
+	var found []*mackerel.GraphAnnotation
+	for _, a := range annotations {
+		if a.From <= to && from <= a.To {
+			found = append(found, a)
+		}
+	}
+	return found, nil
+}
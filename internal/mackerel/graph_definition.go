@@ -0,0 +1,102 @@
+package mackerel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+// GraphDefinitionModel is a graph definition of custom metrics.
+//
+// Mackerel API has no way to read graph definitions, so the model is never refreshed from Mackerel.
+// Posting a graph definition with the same name overwrites the existing one.
+type GraphDefinitionModel struct {
+	ID          types.String                 `tfsdk:"id"`
+	Name        types.String                 `tfsdk:"name"`
+	DisplayName types.String                 `tfsdk:"display_name"`
+	Unit        types.String                 `tfsdk:"unit"`
+	Metrics     []GraphDefinitionMetricModel `tfsdk:"metric"`
+}
+
+type GraphDefinitionMetricModel struct {
+	Name        types.String `tfsdk:"name"`
+	DisplayName types.String `tfsdk:"display_name"`
+	IsStacked   types.Bool   `tfsdk:"is_stacked"`
+}
+
+var graphDefinitionNameRegex = regexp.MustCompile(`^custom\.[-a-zA-Z0-9_.*#]+$`)
+
+func GraphDefinitionNameValidator() validator.String {
+	return stringvalidator.RegexMatches(graphDefinitionNameRegex,
+		"Must start with 'custom.' and include only alphabets, numbers, hyphen, underscore, dot and wildcards (* and #)")
+}
+
+func GraphDefinitionUnitValidator() validator.String {
+	return stringvalidator.OneOf(
+		"float", "integer", "percentage", "seconds", "milliseconds", "bytes", "bytes/sec", "bits/sec", "iops",
+	)
+}
+
+// Imports a graph definition by the name. Attributes other than the name cannot be read.
+// The graph definition cannot be looked up either, so only the format of the name is validated.
+func ImportGraphDefinition(name string) (GraphDefinitionModel, error) {
+	if !graphDefinitionNameRegex.MatchString(name) {
+		return GraphDefinitionModel{}, fmt.Errorf("The ID is expected to be a graph name in `custom.<name>` format, but got: '%s'.", name)
+	}
+	return GraphDefinitionModel{
+		ID:   types.StringValue(name),
+		Name: types.StringValue(name),
+	}, nil
+}
+
+// Creates a graph definition.
+func (m *GraphDefinitionModel) Create(ctx context.Context, client *Client) error {
+	return m.createInner(ctx, client)
+}
+
+type graphDefsCreator interface {
+	CreateGraphDefsContext(context.Context, []*mackerel.GraphDefsParam) error
+}
+
+func (m *GraphDefinitionModel) createInner(ctx context.Context, client graphDefsCreator) error {
+	if err := client.CreateGraphDefsContext(ctx, []*mackerel.GraphDefsParam{m.graphDefsParam()}); err != nil {
+		return err
+	}
+	m.ID = m.Name
+	return nil
+}
+
+// Updates a graph definition by overwriting it.
+func (m *GraphDefinitionModel) Update(ctx context.Context, client *Client) error {
+	return m.createInner(ctx, client)
+}
+
+// Deletes a graph definition. Graph definitions which have already been deleted are ignored.
+func (m GraphDefinitionModel) Delete(ctx context.Context, client *Client) error {
+	if err := client.DeleteGraphDefContext(ctx, m.Name.ValueString()); err != nil && !IsNotFound(wrapNotFound(err)) {
+		return err
+	}
+	return nil
+}
+
+func (m GraphDefinitionModel) graphDefsParam() *mackerel.GraphDefsParam {
+	metrics := make([]*mackerel.GraphDefsMetric, 0, len(m.Metrics))
+	for _, metric := range m.Metrics {
+		metrics = append(metrics, &mackerel.GraphDefsMetric{
+			Name:        metric.Name.ValueString(),
+			DisplayName: metric.DisplayName.ValueString(),
+			IsStacked:   metric.IsStacked.ValueBool(),
+		})
+	}
+	return &mackerel.GraphDefsParam{
+		Name:        m.Name.ValueString(),
+		DisplayName: m.DisplayName.ValueString(),
+		Unit:        m.Unit.ValueString(),
+		Metrics:     metrics,
+	}
+}
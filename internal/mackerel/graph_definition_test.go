@@ -0,0 +1,143 @@
+package mackerel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+func Test_GraphDefinition_Create(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		in GraphDefinitionModel
+
+		wantParam *mackerel.GraphDefsParam
+		wants     GraphDefinitionModel
+	}{
+		"basic": {
+			in: GraphDefinitionModel{
+				Name:        types.StringValue("custom.queue"),
+				DisplayName: types.StringValue("Queue"),
+				Unit:        types.StringValue("integer"),
+				Metrics: []GraphDefinitionMetricModel{
+					{
+						Name:        types.StringValue("custom.queue.waiting"),
+						DisplayName: types.StringValue("Waiting"),
+						IsStacked:   types.BoolValue(true),
+					},
+					{
+						Name:        types.StringValue("custom.queue.running"),
+						DisplayName: types.StringValue(""),
+						IsStacked:   types.BoolValue(false),
+					},
+				},
+			},
+
+			wantParam: &mackerel.GraphDefsParam{
+				Name:        "custom.queue",
+				DisplayName: "Queue",
+				Unit:        "integer",
+				Metrics: []*mackerel.GraphDefsMetric{
+					{Name: "custom.queue.waiting", DisplayName: "Waiting", IsStacked: true},
+					{Name: "custom.queue.running"},
+				},
+			},
+			wants: GraphDefinitionModel{
+				ID:          types.StringValue("custom.queue"),
+				Name:        types.StringValue("custom.queue"),
+				DisplayName: types.StringValue("Queue"),
+				Unit:        types.StringValue("integer"),
+				Metrics: []GraphDefinitionMetricModel{
+					{
+						Name:        types.StringValue("custom.queue.waiting"),
+						DisplayName: types.StringValue("Waiting"),
+						IsStacked:   types.BoolValue(true),
+					},
+					{
+						Name:        types.StringValue("custom.queue.running"),
+						DisplayName: types.StringValue(""),
+						IsStacked:   types.BoolValue(false),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var params []*mackerel.GraphDefsParam
+			client := graphDefsCreatorFunc(func(p []*mackerel.GraphDefsParam) error {
+				params = p
+				return nil
+			})
+
+			data := tt.in
+			if err := data.createInner(context.Background(), client); err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if diff := cmp.Diff([]*mackerel.GraphDefsParam{tt.wantParam}, params); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(tt.wants, data); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func Test_ImportGraphDefinition(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		inID string
+
+		wants   GraphDefinitionModel
+		wantErr bool
+	}{
+		"valid": {
+			inID: "custom.tf.#",
+
+			wants: GraphDefinitionModel{
+				ID:   types.StringValue("custom.tf.#"),
+				Name: types.StringValue("custom.tf.#"),
+			},
+		},
+		"no prefix": {
+			inID:    "tf.graph",
+			wantErr: true,
+		},
+		"invalid character": {
+			inID:    "custom.tf graph",
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := ImportGraphDefinition(tt.inID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.wants, data); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+type graphDefsCreatorFunc func([]*mackerel.GraphDefsParam) error
+
+func (f graphDefsCreatorFunc) CreateGraphDefsContext(_ context.Context, params []*mackerel.GraphDefsParam) error {
+	return f(params)
+}
@@ -0,0 +1,249 @@
+package mackerel
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+type HostModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	DisplayName      types.String `tfsdk:"display_name"`
+	CustomIdentifier types.String `tfsdk:"custom_identifier"`
+	Memo             types.String `tfsdk:"memo"`
+	RoleFullnames    []string     `tfsdk:"role_fullnames"`
+	Status           types.String `tfsdk:"status"`
+}
+
+// HostDataSourceModel is a host with the details which are not managed by the resource.
+type HostDataSourceModel struct {
+	HostModel
+	Interfaces []HostInterfaceModel `tfsdk:"interfaces"`
+}
+
+type HostInterfaceModel struct {
+	Name          types.String `tfsdk:"name"`
+	IPAddress     types.String `tfsdk:"ip_address"`
+	IPv4Addresses []string     `tfsdk:"ipv4_addresses"`
+	IPv6Addresses []string     `tfsdk:"ipv6_addresses"`
+	MacAddress    types.String `tfsdk:"mac_address"`
+}
+
+func HostStatusValidator() validator.String {
+	return stringvalidator.OneOf(
+		mackerel.HostStatusWorking,
+		mackerel.HostStatusStandby,
+		mackerel.HostStatusMaintenance,
+		mackerel.HostStatusPoweroff,
+	)
+}
+
+// Reads a host by the ID. Retired hosts are treated as not found.
+func ReadHost(ctx context.Context, client *Client, id string) (HostModel, error) {
+	return readHostInner(ctx, client, id)
+}
+
+type hostFinder interface {
+	FindHostContext(context.Context, string) (*mackerel.Host, error)
+}
+
+func readHostInner(ctx context.Context, client hostFinder, id string) (HostModel, error) {
+	host, err := client.FindHostContext(ctx, id)
+	if err != nil {
+		return HostModel{}, wrapNotFound(err)
+	}
+	if host.IsRetired {
+		return HostModel{}, newNotFoundError("the host '%s' is retired", id)
+	}
+	return newHost(*host), nil
+}
+
+// Finds a host by one of `id`, `name` and `custom_identifier` of the config.
+func FindHost(ctx context.Context, client *Client, config HostDataSourceModel) (HostDataSourceModel, error) {
+	return findHostInner(ctx, client, config)
+}
+
+type hostSearcher interface {
+	hostFinder
+	FindHostsContext(context.Context, *mackerel.FindHostsParam) ([]*mackerel.Host, error)
+	FindHostByCustomIdentifierContext(context.Context, string, *mackerel.FindHostByCustomIdentifierParam) (*mackerel.Host, error)
+}
+
+func findHostInner(ctx context.Context, client hostSearcher, config HostDataSourceModel) (HostDataSourceModel, error) {
+	var host *mackerel.Host
+	switch {
+	case !config.ID.IsNull():
+		h, err := client.FindHostContext(ctx, config.ID.ValueString())
+		if err != nil {
+			return HostDataSourceModel{}, wrapNotFound(err)
+		}
+		host = h
+	case !config.CustomIdentifier.IsNull():
+		h, err := client.FindHostByCustomIdentifierContext(ctx, config.CustomIdentifier.ValueString(), &mackerel.FindHostByCustomIdentifierParam{})
+		if err != nil {
+			return HostDataSourceModel{}, wrapNotFound(err)
+		}
+		host = h
+	default:
+		name := config.Name.ValueString()
+		hosts, err := client.FindHostsContext(ctx, &mackerel.FindHostsParam{
+			Name: name,
+			// Hosts of any status can be found, as well as by ID.
+			Statuses: []string{
+				mackerel.HostStatusWorking,
+				mackerel.HostStatusStandby,
+				mackerel.HostStatusMaintenance,
+				mackerel.HostStatusPoweroff,
+			},
+		})
+		if err != nil {
+			return HostDataSourceModel{}, err
+		}
+		switch len(hosts) {
+		case 0:
+			return HostDataSourceModel{}, newNotFoundError("the name '%s' does not match any host in mackerel.io", name)
+		case 1:
+			host = hosts[0]
+		default:
+			return HostDataSourceModel{}, fmt.Errorf("the name '%s' matches %d hosts, use id or custom_identifier instead", name, len(hosts))
+		}
+	}
+
+	if host.IsRetired {
+		return HostDataSourceModel{}, newNotFoundError("the host '%s' is retired", host.ID)
+	}
+	return newHostDataSource(*host), nil
+}
+
+// Creates a host. The status is updated after creation if it is specified.
+// The ID is set as soon as the host is created, even if the rest fails, so that the host can be tracked.
+func (m *HostModel) Create(ctx context.Context, client *Client) error {
+	param := mackerel.CreateHostParam{
+		Name:             m.Name.ValueString(),
+		DisplayName:      m.DisplayName.ValueString(),
+		CustomIdentifier: m.CustomIdentifier.ValueString(),
+		Memo:             m.Memo.ValueString(),
+		RoleFullnames:    nilAsEmptySlice(m.RoleFullnames),
+		Interfaces:       []mackerel.Interface{},
+		Checks:           []mackerel.CheckConfig{},
+	}
+	id, err := client.CreateHostContext(ctx, &param)
+	if err != nil {
+		return err
+	}
+	m.ID = types.StringValue(id)
+
+	if status := m.Status.ValueString(); status != "" {
+		if err := client.UpdateHostStatusContext(ctx, id, status); err != nil {
+			return err
+		}
+	}
+	return m.Read(ctx, client)
+}
+
+// Reads a host.
+func (m *HostModel) Read(ctx context.Context, client *Client) error {
+	newModel, err := ReadHost(ctx, client, m.ID.ValueString())
+	if err != nil {
+		return err
+	}
+	*m = newModel
+	return nil
+}
+
+// Updates a host.
+// Interfaces, meta and checks of the host, which are not managed by this model, are kept as they are.
+func (m *HostModel) Update(ctx context.Context, client *Client) error {
+	id := m.ID.ValueString()
+	current, err := client.FindHostContext(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	param := updateHostParam{
+		Name:             m.Name.ValueString(),
+		DisplayName:      m.DisplayName.ValueString(),
+		CustomIdentifier: m.CustomIdentifier.ValueString(),
+		Memo:             m.Memo.ValueString(),
+		Meta:             current.Meta,
+		Interfaces:       nilAsEmptySlice(current.Interfaces),
+		RoleFullnames:    nilAsEmptySlice(m.RoleFullnames),
+	}
+	if err := client.updateHostContext(ctx, id, &param); err != nil {
+		return err
+	}
+
+	if status := m.Status.ValueString(); status != "" && status != current.Status {
+		if err := client.UpdateHostStatusContext(ctx, id, status); err != nil {
+			return err
+		}
+	}
+	return m.Read(ctx, client)
+}
+
+// updateHostParam is mackerel.UpdateHostParam without `checks`.
+// The host cannot be read with its checks, so they are left out of updates instead of being replaced.
+type updateHostParam struct {
+	Name             string               `json:"name"`
+	DisplayName      string               `json:"displayName,omitempty"`
+	Memo             string               `json:"memo,omitempty"`
+	Meta             mackerel.HostMeta    `json:"meta"`
+	Interfaces       []mackerel.Interface `json:"interfaces"`
+	RoleFullnames    []string             `json:"roleFullnames"`
+	CustomIdentifier string               `json:"customIdentifier,omitempty"`
+}
+
+// Updates a host without touching the check monitors registered by the agent.
+// UpdateHostContext of mackerel-client-go always sends `checks`, which clears them.
+func (c *Client) updateHostContext(ctx context.Context, id string, param *updateHostParam) error {
+	resp, err := c.PutJSONContext(ctx, "/api/v0/hosts/"+id, param)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// Retires a host. Retired hosts cannot be restored.
+func (m HostModel) Delete(ctx context.Context, client *Client) error {
+	if err := client.RetireHostContext(ctx, m.ID.ValueString()); err != nil {
+		return err
+	}
+	return nil
+}
+
+func newHost(h mackerel.Host) HostModel {
+	roleFullnames := h.GetRoleFullnames()
+	slices.Sort(roleFullnames)
+	return HostModel{
+		ID:               types.StringValue(h.ID),
+		Name:             types.StringValue(h.Name),
+		DisplayName:      types.StringValue(h.DisplayName),
+		CustomIdentifier: types.StringValue(h.CustomIdentifier),
+		Memo:             types.StringValue(h.Memo),
+		RoleFullnames:    nilAsEmptySlice(roleFullnames),
+		Status:           types.StringValue(h.Status),
+	}
+}
+
+func newHostDataSource(h mackerel.Host) HostDataSourceModel {
+	interfaces := make([]HostInterfaceModel, 0, len(h.Interfaces))
+	for _, iface := range h.Interfaces {
+		interfaces = append(interfaces, HostInterfaceModel{
+			Name:          types.StringValue(iface.Name),
+			IPAddress:     types.StringValue(iface.IPAddress),
+			IPv4Addresses: nilAsEmptySlice(iface.IPv4Addresses),
+			IPv6Addresses: nilAsEmptySlice(iface.IPv6Addresses),
+			MacAddress:    types.StringValue(iface.MacAddress),
+		})
+	}
+	return HostDataSourceModel{
+		HostModel:  newHost(h),
+		Interfaces: interfaces,
+	}
+}
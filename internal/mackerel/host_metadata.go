@@ -0,0 +1,166 @@
+package mackerel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+type HostMetadataModel struct {
+	ID           types.String         `tfsdk:"id"`
+	HostID       types.String         `tfsdk:"host_id"`
+	Namespace    types.String         `tfsdk:"namespace"`
+	MetadataJSON jsontypes.Normalized `tfsdk:"metadata_json"`
+}
+
+func hostMetadataID(hostID, namespace string) string {
+	return fmt.Sprintf("%s/%s", hostID, namespace)
+}
+
+func parseHostMetadataID(id string) (hostID, namespace string, err error) {
+	hid, ns, foundSlash := strings.Cut(id, "/")
+	if !foundSlash {
+		return "", "", fmt.Errorf("The ID is expected to have `<host_id>/<namespace>` format, but got: '%s'.", id)
+	}
+	return hid, ns, nil
+}
+
+func ReadHostMetadata(ctx context.Context, client *Client, hostID, namespace string) (HostMetadataModel, error) {
+	return readHostMetadataInner(ctx, client, hostID, namespace)
+}
+
+type hostMetadataGetter interface {
+	GetHostMetaDataContext(ctx context.Context, hostID, namespace string) (*mackerel.HostMetaDataResp, error)
+}
+
+func readHostMetadataInner(ctx context.Context, client hostMetadataGetter, hostID, namespace string) (HostMetadataModel, error) {
+	metadataResp, err := client.GetHostMetaDataContext(ctx, hostID, namespace)
+	if err != nil {
+		return HostMetadataModel{}, wrapNotFound(err)
+	}
+
+	metadataJSON, err := json.Marshal(metadataResp.HostMetaData)
+	if err != nil {
+		return HostMetadataModel{}, fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return HostMetadataModel{
+		ID:           types.StringValue(hostMetadataID(hostID, namespace)),
+		HostID:       types.StringValue(hostID),
+		Namespace:    types.StringValue(namespace),
+		MetadataJSON: jsontypes.NewNormalizedValue(string(metadataJSON)),
+	}, nil
+}
+
+func ImportHostMetadata(id string) (HostMetadataModel, error) {
+	hostID, namespace, err := parseHostMetadataID(id)
+	if err != nil {
+		return HostMetadataModel{}, err
+	}
+	return HostMetadataModel{
+		ID:        types.StringValue(id),
+		HostID:    types.StringValue(hostID),
+		Namespace: types.StringValue(namespace),
+	}, nil
+}
+
+func (m *HostMetadataModel) Validate(base path.Path) (diags diag.Diagnostics) {
+	if m.ID.IsNull() || m.ID.IsUnknown() {
+		return
+	}
+	id := m.ID.ValueString()
+	idPath := base.AtName("id")
+
+	hostID, namespace, err := parseHostMetadataID(id)
+	if err != nil {
+		diags.AddAttributeError(
+			idPath,
+			"Invalid ID",
+			err.Error(),
+		)
+		return
+	}
+
+	if !m.HostID.IsNull() && !m.HostID.IsUnknown() && m.HostID.ValueString() != hostID {
+		diags.AddAttributeError(
+			idPath,
+			"Invalid ID",
+			fmt.Sprintf("ID is expected to start with '%s/', but got: '%s'", m.HostID.ValueString(), id),
+		)
+	}
+	if !m.Namespace.IsNull() && !m.Namespace.IsUnknown() && m.Namespace.ValueString() != namespace {
+		diags.AddAttributeError(
+			idPath,
+			"Invalid ID",
+			fmt.Sprintf("ID is expected to end with '/%s', but got: '%s'", m.Namespace.ValueString(), id),
+		)
+	}
+
+	return
+}
+
+func (m *HostMetadataModel) Create(ctx context.Context, client *Client) error {
+	return m.create(ctx, client)
+}
+
+func (m *HostMetadataModel) create(ctx context.Context, client hostMetadataPutter) error {
+	if err := m.update(ctx, client); err != nil {
+		return err
+	}
+
+	m.ID = types.StringValue(hostMetadataID(m.HostID.ValueString(), m.Namespace.ValueString()))
+	return nil
+}
+
+func (m *HostMetadataModel) Read(ctx context.Context, client *Client) error {
+	data, err := ReadHostMetadata(ctx, client, m.HostID.ValueString(), m.Namespace.ValueString())
+	if err != nil {
+		return err
+	}
+
+	m.ID = data.ID // computed
+	m.MetadataJSON = data.MetadataJSON
+	return nil
+}
+
+func (m HostMetadataModel) Update(ctx context.Context, client *Client) error {
+	return m.update(ctx, client)
+}
+
+type hostMetadataPutter interface {
+	PutHostMetaDataContext(ctx context.Context, hostID, namespace string, metadata mackerel.HostMetaData) error
+}
+
+func (m *HostMetadataModel) update(ctx context.Context, client hostMetadataPutter) error {
+	var metadata mackerel.HostMetaData
+	if err := json.Unmarshal([]byte(m.MetadataJSON.ValueString()), &metadata); err != nil {
+		return fmt.Errorf("failed to unmarshal metadata: %w", err)
+	}
+	if err := client.PutHostMetaDataContext(
+		ctx,
+		m.HostID.ValueString(),
+		m.Namespace.ValueString(),
+		metadata,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m HostMetadataModel) Delete(ctx context.Context, client *Client) error {
+	if err := client.DeleteHostMetaDataContext(
+		ctx,
+		m.HostID.ValueString(),
+		m.Namespace.ValueString(),
+	); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,254 @@
+package mackerel
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+func Test_ReadHostMetadata(t *testing.T) {
+	t.Parallel()
+
+	defaultClient := func(hostID, namespace string) (*mackerel.HostMetaDataResp, error) {
+		if hostID != "host0" || namespace != "inventory" {
+			return nil, fmt.Errorf("no metadata found")
+		}
+		return &mackerel.HostMetaDataResp{
+			HostMetaData: map[string]any{"owner": "sre", "rack": "a-1"},
+		}, nil
+	}
+
+	cases := map[string]struct {
+		inClient    hostMetadataGetterFunc
+		inHostID    string
+		inNamespace string
+
+		wants   HostMetadataModel
+		wantErr bool
+	}{
+		"basic": {
+			inClient:    defaultClient,
+			inHostID:    "host0",
+			inNamespace: "inventory",
+
+			wants: HostMetadataModel{
+				ID:           types.StringValue("host0/inventory"),
+				HostID:       types.StringValue("host0"),
+				Namespace:    types.StringValue("inventory"),
+				MetadataJSON: jsontypes.NewNormalizedValue(`{"owner":"sre","rack":"a-1"}`),
+			},
+		},
+		"not found": {
+			inClient:    defaultClient,
+			inHostID:    "host1",
+			inNamespace: "inventory",
+
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := readHostMetadataInner(context.Background(), tt.inClient, tt.inHostID, tt.inNamespace)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(data, tt.wants); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func Test_ImportHostMetadata(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		inID string
+
+		wants   HostMetadataModel
+		wantErr bool
+	}{
+		"valid": {
+			inID: "host0/inventory",
+
+			wants: HostMetadataModel{
+				ID:        types.StringValue("host0/inventory"),
+				HostID:    types.StringValue("host0"),
+				Namespace: types.StringValue("inventory"),
+			},
+		},
+		"invalid": {
+			inID: "invalidid",
+
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := ImportHostMetadata(tt.inID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("unexpected error: %+v", err)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(data, tt.wants); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func Test_HostMetadata_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		in HostMetadataModel
+
+		wantError   bool
+		wantErrorIn path.Expressions
+	}{
+		"valid": {
+			in: HostMetadataModel{
+				ID:        types.StringValue("host0/inventory"),
+				HostID:    types.StringValue("host0"),
+				Namespace: types.StringValue("inventory"),
+			},
+		},
+		"invalid id syntax": {
+			in: HostMetadataModel{
+				ID: types.StringValue("host0,inventory"),
+			},
+			wantError:   true,
+			wantErrorIn: path.Expressions{path.MatchRoot("id")},
+		},
+		"unmatched host": {
+			in: HostMetadataModel{
+				ID:        types.StringValue("host0/inventory"),
+				HostID:    types.StringValue("host1"),
+				Namespace: types.StringValue("inventory"),
+			},
+			wantError:   true,
+			wantErrorIn: path.Expressions{path.MatchRoot("id")},
+		},
+		"unmatched namespace": {
+			in: HostMetadataModel{
+				ID:        types.StringValue("host0/inventory0"),
+				HostID:    types.StringValue("host0"),
+				Namespace: types.StringValue("inventory1"),
+			},
+			wantError:   true,
+			wantErrorIn: path.Expressions{path.MatchRoot("id")},
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tt.in.Validate(path.Empty())
+			for _, d := range diags {
+				if d.Severity() != diag.SeverityError {
+					continue
+				}
+				dwp, ok := d.(diag.DiagnosticWithPath)
+				if ok {
+					p := dwp.Path()
+					if slices.ContainsFunc(tt.wantErrorIn, func(expr path.Expression) bool {
+						return expr.Matches(p)
+					}) {
+						continue
+					}
+				} else if tt.wantError {
+					continue
+				}
+				t.Errorf("unexpected error: %v", d)
+			}
+		})
+	}
+}
+
+func Test_HostMetadata_Create(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		in       HostMetadataModel
+		inClient hostMetadataPutterFunc
+
+		wants HostMetadataModel
+	}{
+		"basic": {
+			in: HostMetadataModel{
+				HostID:       types.StringValue("host0"),
+				Namespace:    types.StringValue("inventory"),
+				MetadataJSON: jsontypes.NewNormalizedValue(`{"owner":"sre"}`),
+			},
+			inClient: func(hostID, namespace string, metadata mackerel.HostMetaData) error {
+				if hostID != "host0" {
+					return fmt.Errorf("unexpected host id: %s", hostID)
+				}
+				if namespace != "inventory" {
+					return fmt.Errorf("unexpected namespace: %s", namespace)
+				}
+				if diff := cmp.Diff(metadata, map[string]any{"owner": "sre"}); diff != "" {
+					return fmt.Errorf("unexpected metadata: %s", diff)
+				}
+				return nil
+			},
+
+			wants: HostMetadataModel{
+				ID:           types.StringValue("host0/inventory"),
+				HostID:       types.StringValue("host0"),
+				Namespace:    types.StringValue("inventory"),
+				MetadataJSON: jsontypes.NewNormalizedValue(`{"owner":"sre"}`),
+			},
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := tt.in
+			if err := data.create(context.Background(), tt.inClient); err != nil {
+				t.Errorf("unexpected error: %+v", err)
+				return
+			}
+
+			if diff := cmp.Diff(data, tt.wants); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+type hostMetadataGetterFunc func(hostID, namespace string) (*mackerel.HostMetaDataResp, error)
+
+func (f hostMetadataGetterFunc) GetHostMetaDataContext(_ context.Context, hostID, namespace string) (*mackerel.HostMetaDataResp, error) {
+	return f(hostID, namespace)
+}
+
+type hostMetadataPutterFunc func(hostID, namespace string, metadata mackerel.HostMetaData) error
+
+func (f hostMetadataPutterFunc) PutHostMetaDataContext(_ context.Context, hostID, namespace string, metadata mackerel.HostMetaData) error {
+	return f(hostID, namespace, metadata)
+}
@@ -0,0 +1,174 @@
+package mackerel
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// HostRoleAttachmentModel attaches a role to a host without managing the other roles of the host,
+// which may be given by mackerel-agent or other tools.
+type HostRoleAttachmentModel struct {
+	ID           types.String `tfsdk:"id"`
+	HostID       types.String `tfsdk:"host_id"`
+	RoleFullname types.String `tfsdk:"role_fullname"`
+}
+
+const (
+	// The maximum number of attempts to update roles of a host which are modified concurrently.
+	hostRoleUpdateMaxAttempts = 5
+	hostRoleUpdateRetryWait   = 1 * time.Second
+)
+
+// Serializes the role updates of each host in the provider,
+// so that attachments to the same host in one apply do not overwrite each other's roles.
+var hostRoleLocks keyedMutex
+
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// Locks the key and returns the function to unlock it.
+func (k *keyedMutex) lock(key string) (unlock func()) {
+	k.mu.Lock()
+	if k.locks == nil {
+		k.locks = map[string]*sync.Mutex{}
+	}
+	l, ok := k.locks[key]
+	if !ok {
+		l = &sync.Mutex{}
+		k.locks[key] = l
+	}
+	k.mu.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
+
+func hostRoleAttachmentID(hostID, roleFullname string) string {
+	return fmt.Sprintf("%s/%s", hostID, roleFullname)
+}
+
+func parseHostRoleAttachmentID(id string) (hostID, roleFullname string, err error) {
+	hid, rf, foundSlash := strings.Cut(id, "/")
+	if !foundSlash || !strings.Contains(rf, ":") {
+		return "", "", fmt.Errorf("The ID is expected to have `<host_id>/<service>:<role>` format, but got: '%s'.", id)
+	}
+	return hid, rf, nil
+}
+
+func ImportHostRoleAttachment(id string) (HostRoleAttachmentModel, error) {
+	hostID, roleFullname, err := parseHostRoleAttachmentID(id)
+	if err != nil {
+		return HostRoleAttachmentModel{}, err
+	}
+	return HostRoleAttachmentModel{
+		ID:           types.StringValue(id),
+		HostID:       types.StringValue(hostID),
+		RoleFullname: types.StringValue(roleFullname),
+	}, nil
+}
+
+// Reads the attachment. It is not found if the host is retired or does not have the role.
+func ReadHostRoleAttachment(ctx context.Context, client *Client, hostID, roleFullname string) (HostRoleAttachmentModel, error) {
+	return readHostRoleAttachmentInner(ctx, client, hostID, roleFullname)
+}
+
+func readHostRoleAttachmentInner(ctx context.Context, client hostFinder, hostID, roleFullname string) (HostRoleAttachmentModel, error) {
+	host, err := readHostInner(ctx, client, hostID)
+	if err != nil {
+		return HostRoleAttachmentModel{}, err
+	}
+	if !slices.Contains(host.RoleFullnames, roleFullname) {
+		return HostRoleAttachmentModel{}, newNotFoundError("the host '%s' does not have the role '%s'", hostID, roleFullname)
+	}
+	return HostRoleAttachmentModel{
+		ID:           types.StringValue(hostRoleAttachmentID(hostID, roleFullname)),
+		HostID:       types.StringValue(hostID),
+		RoleFullname: types.StringValue(roleFullname),
+	}, nil
+}
+
+func (m *HostRoleAttachmentModel) Create(ctx context.Context, client *Client) error {
+	hostID, roleFullname := m.HostID.ValueString(), m.RoleFullname.ValueString()
+	if err := updateHostRoleInner(ctx, client, hostID, roleFullname, true, hostRoleUpdateRetryWait); err != nil {
+		return err
+	}
+	m.ID = types.StringValue(hostRoleAttachmentID(hostID, roleFullname))
+	return nil
+}
+
+func (m *HostRoleAttachmentModel) Read(ctx context.Context, client *Client) error {
+	data, err := ReadHostRoleAttachment(ctx, client, m.HostID.ValueString(), m.RoleFullname.ValueString())
+	if err != nil {
+		return err
+	}
+	*m = data
+	return nil
+}
+
+// Detaches the role. Retired hosts are ignored because they have no roles.
+func (m HostRoleAttachmentModel) Delete(ctx context.Context, client *Client) error {
+	err := updateHostRoleInner(ctx, client, m.HostID.ValueString(), m.RoleFullname.ValueString(), false, hostRoleUpdateRetryWait)
+	if IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+type hostRolesUpdater interface {
+	hostFinder
+	UpdateHostRoleFullnamesContext(context.Context, string, []string) error
+}
+
+// Adds (attach = true) or removes (attach = false) the role of the host, keeping the other roles.
+//
+// Mackerel API only has the way to replace all roles of a host, so the update is a read-modify-write.
+// Updates within the provider are serialized per host, and the roles are read again after the update
+// to confirm that the change is not overwritten by others outside the provider, such as mackerel-agent.
+// The update is retried until the host has (or does not have) the role.
+func updateHostRoleInner(ctx context.Context, client hostRolesUpdater, hostID, roleFullname string, attach bool, retryWait time.Duration) error {
+	defer hostRoleLocks.lock(hostID)()
+
+	for attempt := 1; ; attempt++ {
+		host, err := readHostInner(ctx, client, hostID)
+		if err != nil {
+			return err
+		}
+		if slices.Contains(host.RoleFullnames, roleFullname) == attach {
+			return nil
+		}
+
+		roleFullnames := slices.DeleteFunc(host.RoleFullnames, func(rf string) bool { return rf == roleFullname })
+		if attach {
+			roleFullnames = append(roleFullnames, roleFullname)
+		}
+		if err := client.UpdateHostRoleFullnamesContext(ctx, hostID, roleFullnames); err != nil {
+			return err
+		}
+		host, err = readHostInner(ctx, client, hostID)
+		if err != nil {
+			return err
+		}
+		if slices.Contains(host.RoleFullnames, roleFullname) == attach {
+			return nil
+		}
+
+		if attempt >= hostRoleUpdateMaxAttempts {
+			return fmt.Errorf("the roles of the host '%s' were modified concurrently, gave up updating them after %d attempts", hostID, attempt)
+		}
+		timer := time.NewTimer(retryWait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,302 @@
+package mackerel
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+func Test_HostRoleAttachment_updateHostRoleInner(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		inRoles  []string
+		inAttach bool
+		// Errors returned by UpdateHostRoleFullnames in order.
+		updateErrors []error
+		// Roles which another writer puts right after each update.
+		overwrites [][]string
+
+		wantRoles   []string
+		wantUpdates int
+		wantErr     bool
+	}{
+		"attach": {
+			inRoles:  []string{"agent:web"},
+			inAttach: true,
+
+			wantRoles:   []string{"agent:web", "app:batch"},
+			wantUpdates: 1,
+		},
+		"already attached": {
+			inRoles:  []string{"agent:web", "app:batch"},
+			inAttach: true,
+
+			wantRoles:   []string{"agent:web", "app:batch"},
+			wantUpdates: 0,
+		},
+		"detach": {
+			inRoles:  []string{"agent:web", "app:batch"},
+			inAttach: false,
+
+			wantRoles:   []string{"agent:web"},
+			wantUpdates: 1,
+		},
+		"retry when overwritten": {
+			inRoles:    []string{"agent:web"},
+			inAttach:   true,
+			overwrites: [][]string{{"agent:web", "agent:db"}},
+
+			wantRoles:   []string{"agent:web", "agent:db", "app:batch"},
+			wantUpdates: 2,
+		},
+		"give up": {
+			inRoles:  []string{"agent:web"},
+			inAttach: true,
+			overwrites: [][]string{
+				{"agent:web"}, {"agent:web"}, {"agent:web"}, {"agent:web"}, {"agent:web"},
+			},
+
+			wantUpdates: hostRoleUpdateMaxAttempts,
+			wantErr:     true,
+		},
+		"other errors": {
+			inRoles:      []string{"agent:web"},
+			inAttach:     true,
+			updateErrors: []error{&mackerel.APIError{StatusCode: http.StatusBadRequest}},
+
+			wantUpdates: 1,
+			wantErr:     true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			host := &mackerel.Host{ID: "host0", Roles: rolesOf(tt.inRoles)}
+			updates := 0
+			client := hostRolesUpdaterFunc{
+				find: func(id string) (*mackerel.Host, error) {
+					if id != host.ID {
+						return nil, &mackerel.APIError{StatusCode: http.StatusNotFound}
+					}
+					return host, nil
+				},
+				update: func(_ string, roleFullnames []string) error {
+					updates++
+					if updates <= len(tt.updateErrors) && tt.updateErrors[updates-1] != nil {
+						return tt.updateErrors[updates-1]
+					}
+					host = &mackerel.Host{ID: host.ID, Roles: rolesOf(roleFullnames)}
+					if updates <= len(tt.overwrites) {
+						host = &mackerel.Host{ID: host.ID, Roles: rolesOf(tt.overwrites[updates-1])}
+					}
+					return nil
+				},
+			}
+
+			err := updateHostRoleInner(context.Background(), client, "host0", "app:batch", tt.inAttach, 0)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if updates != tt.wantUpdates {
+				t.Errorf("expected %d updates, but got %d", tt.wantUpdates, updates)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(newHost(*host).RoleFullnames, sortedCopy(tt.wantRoles)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func Test_HostRoleAttachment_updateHostRoleInner_parallel(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	updates := 0
+	host := &mackerel.Host{ID: "host-parallel", Roles: rolesOf([]string{"agent:web"})}
+	client := hostRolesUpdaterFunc{
+		find: func(string) (*mackerel.Host, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return host, nil
+		},
+		update: func(id string, roleFullnames []string) error {
+			// Let the other updates read the roles before this update lands.
+			time.Sleep(10 * time.Millisecond)
+			mu.Lock()
+			defer mu.Unlock()
+			updates++
+			host = &mackerel.Host{ID: id, Roles: rolesOf(roleFullnames)}
+			return nil
+		},
+	}
+
+	roles := []string{"app:batch", "app:worker", "app:cron"}
+	var wg sync.WaitGroup
+	for _, rf := range roles {
+		wg.Go(func() {
+			if err := updateHostRoleInner(context.Background(), client, "host-parallel", rf, true, 0); err != nil {
+				t.Errorf("unexpected error: %+v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	// Each role is written once, without being overwritten and retried.
+	if updates != len(roles) {
+		t.Errorf("expected %d updates, but got %d", len(roles), updates)
+	}
+	if diff := cmp.Diff(newHost(*host).RoleFullnames, sortedCopy(append(roles, "agent:web"))); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func Test_ReadHostRoleAttachment(t *testing.T) {
+	t.Parallel()
+
+	client := hostFinderFunc(func(id string) (*mackerel.Host, error) {
+		switch id {
+		case "host0":
+			return &mackerel.Host{ID: "host0", Roles: rolesOf([]string{"app:batch"})}, nil
+		case "retired":
+			return &mackerel.Host{ID: "retired", Roles: rolesOf([]string{"app:batch"}), IsRetired: true}, nil
+		default:
+			return nil, &mackerel.APIError{StatusCode: http.StatusNotFound}
+		}
+	})
+
+	cases := map[string]struct {
+		inHostID       string
+		inRoleFullname string
+
+		wants        HostRoleAttachmentModel
+		wantNotFound bool
+	}{
+		"attached": {
+			inHostID:       "host0",
+			inRoleFullname: "app:batch",
+
+			wants: HostRoleAttachmentModel{
+				ID:           types.StringValue("host0/app:batch"),
+				HostID:       types.StringValue("host0"),
+				RoleFullname: types.StringValue("app:batch"),
+			},
+		},
+		"detached": {
+			inHostID:       "host0",
+			inRoleFullname: "app:web",
+
+			wantNotFound: true,
+		},
+		"retired": {
+			inHostID:       "retired",
+			inRoleFullname: "app:batch",
+
+			wantNotFound: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := readHostRoleAttachmentInner(context.Background(), client, tt.inHostID, tt.inRoleFullname)
+			if IsNotFound(err) != tt.wantNotFound {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(data, tt.wants); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func Test_ImportHostRoleAttachment(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		inID string
+
+		wants   HostRoleAttachmentModel
+		wantErr bool
+	}{
+		"valid": {
+			inID: "host0/app:batch",
+
+			wants: HostRoleAttachmentModel{
+				ID:           types.StringValue("host0/app:batch"),
+				HostID:       types.StringValue("host0"),
+				RoleFullname: types.StringValue("app:batch"),
+			},
+		},
+		"no role": {
+			inID:    "host0",
+			wantErr: true,
+		},
+		"invalid role fullname": {
+			inID:    "host0/batch",
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := ImportHostRoleAttachment(tt.inID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(data, tt.wants); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+type hostRolesUpdaterFunc struct {
+	find   func(id string) (*mackerel.Host, error)
+	update func(id string, roleFullnames []string) error
+}
+
+func (f hostRolesUpdaterFunc) FindHostContext(_ context.Context, id string) (*mackerel.Host, error) {
+	return f.find(id)
+}
+
+func (f hostRolesUpdaterFunc) UpdateHostRoleFullnamesContext(_ context.Context, id string, roleFullnames []string) error {
+	return f.update(id, roleFullnames)
+}
+
+func rolesOf(roleFullnames []string) mackerel.Roles {
+	roles := mackerel.Roles{}
+	for _, rf := range roleFullnames {
+		service, role, _ := strings.Cut(rf, ":")
+		roles[service] = append(roles[service], role)
+	}
+	return roles
+}
+
+func sortedCopy(s []string) []string {
+	s = slices.Clone(s)
+	slices.Sort(s)
+	return s
+}
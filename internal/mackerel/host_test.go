@@ -0,0 +1,297 @@
+package mackerel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+func Test_Host_readHostInner(t *testing.T) {
+	t.Parallel()
+
+	client := hostFinderFunc(func(id string) (*mackerel.Host, error) {
+		switch id {
+		case "3ABCDEFGhij":
+			return &mackerel.Host{
+				ID:               "3ABCDEFGhij",
+				Name:             "core-router-01",
+				DisplayName:      "Core Router 01",
+				CustomIdentifier: "core-router-01.example.com",
+				Memo:             "This host is managed by Terraform.",
+				Status:           mackerel.HostStatusWorking,
+				Roles: mackerel.Roles{
+					"network": {"router", "edge"},
+				},
+			}, nil
+		case "3Bare":
+			return &mackerel.Host{
+				ID:     "3Bare",
+				Name:   "bare",
+				Status: mackerel.HostStatusStandby,
+			}, nil
+		case "3Retired":
+			return &mackerel.Host{
+				ID:        "3Retired",
+				Name:      "retired",
+				IsRetired: true,
+			}, nil
+		default:
+			return nil, &mackerel.APIError{StatusCode: 404, Message: "Host Not Found"}
+		}
+	})
+
+	cases := map[string]struct {
+		inID         string
+		want         HostModel
+		wantNotFound bool
+	}{
+		"full": {
+			inID: "3ABCDEFGhij",
+			want: HostModel{
+				ID:               types.StringValue("3ABCDEFGhij"),
+				Name:             types.StringValue("core-router-01"),
+				DisplayName:      types.StringValue("Core Router 01"),
+				CustomIdentifier: types.StringValue("core-router-01.example.com"),
+				Memo:             types.StringValue("This host is managed by Terraform."),
+				RoleFullnames:    []string{"network:edge", "network:router"},
+				Status:           types.StringValue("working"),
+			},
+		},
+		"bare": {
+			inID: "3Bare",
+			want: HostModel{
+				ID:               types.StringValue("3Bare"),
+				Name:             types.StringValue("bare"),
+				DisplayName:      types.StringValue(""),
+				CustomIdentifier: types.StringValue(""),
+				Memo:             types.StringValue(""),
+				RoleFullnames:    []string{},
+				Status:           types.StringValue("standby"),
+			},
+		},
+		"retired": {
+			inID:         "3Retired",
+			wantNotFound: true,
+		},
+		"not found": {
+			inID:         "3Missing",
+			wantNotFound: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := readHostInner(context.Background(), client, tt.inID)
+			if IsNotFound(err) != tt.wantNotFound {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+type hostFinderFunc func(string) (*mackerel.Host, error)
+
+func (f hostFinderFunc) FindHostContext(_ context.Context, id string) (*mackerel.Host, error) {
+	return f(id)
+}
+
+func Test_Host_findHostInner(t *testing.T) {
+	t.Parallel()
+
+	host := &mackerel.Host{
+		ID:               "3ABCDEFGhij",
+		Name:             "core-router-01",
+		CustomIdentifier: "core-router-01.example.com",
+		Status:           mackerel.HostStatusWorking,
+		Interfaces: []mackerel.Interface{
+			{Name: "eth0", IPAddress: "192.0.2.1", IPv4Addresses: []string{"192.0.2.1"}},
+		},
+	}
+	client := hostSearcherMock{
+		findHost: func(id string) (*mackerel.Host, error) {
+			if id == host.ID {
+				return host, nil
+			}
+			return nil, &mackerel.APIError{StatusCode: 404, Message: "Host Not Found"}
+		},
+		findHosts: func(param *mackerel.FindHostsParam) ([]*mackerel.Host, error) {
+			switch param.Name {
+			case host.Name:
+				return []*mackerel.Host{host}, nil
+			case "duplicated":
+				return []*mackerel.Host{host, host}, nil
+			default:
+				return []*mackerel.Host{}, nil
+			}
+		},
+		findHostByCustomIdentifier: func(customIdentifier string) (*mackerel.Host, error) {
+			if customIdentifier == host.CustomIdentifier {
+				return host, nil
+			}
+			return nil, &mackerel.APIError{StatusCode: 404, Message: "Host Not Found"}
+		},
+	}
+
+	want := HostDataSourceModel{
+		HostModel: HostModel{
+			ID:               types.StringValue("3ABCDEFGhij"),
+			Name:             types.StringValue("core-router-01"),
+			DisplayName:      types.StringValue(""),
+			CustomIdentifier: types.StringValue("core-router-01.example.com"),
+			Memo:             types.StringValue(""),
+			RoleFullnames:    []string{},
+			Status:           types.StringValue("working"),
+		},
+		Interfaces: []HostInterfaceModel{{
+			Name:          types.StringValue("eth0"),
+			IPAddress:     types.StringValue("192.0.2.1"),
+			IPv4Addresses: []string{"192.0.2.1"},
+			IPv6Addresses: []string{},
+			MacAddress:    types.StringValue(""),
+		}},
+	}
+
+	cases := map[string]struct {
+		in           HostModel
+		wantErr      bool
+		wantNotFound bool
+	}{
+		"by id": {
+			in: HostModel{ID: types.StringValue("3ABCDEFGhij"), Name: types.StringNull(), CustomIdentifier: types.StringNull()},
+		},
+		"by name": {
+			in: HostModel{ID: types.StringNull(), Name: types.StringValue("core-router-01"), CustomIdentifier: types.StringNull()},
+		},
+		"by custom identifier": {
+			in: HostModel{ID: types.StringNull(), Name: types.StringNull(), CustomIdentifier: types.StringValue("core-router-01.example.com")},
+		},
+		"no such name": {
+			in:           HostModel{ID: types.StringNull(), Name: types.StringValue("missing"), CustomIdentifier: types.StringNull()},
+			wantErr:      true,
+			wantNotFound: true,
+		},
+		"duplicated name": {
+			in:      HostModel{ID: types.StringNull(), Name: types.StringValue("duplicated"), CustomIdentifier: types.StringNull()},
+			wantErr: true,
+		},
+		"no such custom identifier": {
+			in:           HostModel{ID: types.StringNull(), Name: types.StringNull(), CustomIdentifier: types.StringValue("missing")},
+			wantErr:      true,
+			wantNotFound: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := findHostInner(context.Background(), client, HostDataSourceModel{HostModel: tt.in})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if IsNotFound(err) != tt.wantNotFound {
+				t.Errorf("unexpected error: %+v", err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+type hostSearcherMock struct {
+	findHost                   func(string) (*mackerel.Host, error)
+	findHosts                  func(*mackerel.FindHostsParam) ([]*mackerel.Host, error)
+	findHostByCustomIdentifier func(string) (*mackerel.Host, error)
+}
+
+func (m hostSearcherMock) FindHostContext(_ context.Context, id string) (*mackerel.Host, error) {
+	return m.findHost(id)
+}
+
+func (m hostSearcherMock) FindHostsContext(_ context.Context, param *mackerel.FindHostsParam) ([]*mackerel.Host, error) {
+	return m.findHosts(param)
+}
+
+func (m hostSearcherMock) FindHostByCustomIdentifierContext(_ context.Context, customIdentifier string, _ *mackerel.FindHostByCustomIdentifierParam) (*mackerel.Host, error) {
+	return m.findHostByCustomIdentifier(customIdentifier)
+}
+
+func Test_Host_Create_partialFailure(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/v0/hosts":
+			fmt.Fprint(w, `{"id":"3New"}`)
+		default:
+			http.Error(w, `{"error":{"message":"internal error"}}`, http.StatusInternalServerError)
+		}
+	}))
+
+	m := HostModel{
+		Name:   types.StringValue("new"),
+		Status: types.StringValue(mackerel.HostStatusStandby),
+	}
+	if err := m.Create(context.Background(), client); err == nil {
+		t.Fatal("expected an error, but got nil")
+	}
+	if got := m.ID.ValueString(); got != "3New" {
+		t.Errorf("expected the ID to be '3New', but got '%s'", got)
+	}
+}
+
+func Test_Host_Update_keepsChecks(t *testing.T) {
+	t.Parallel()
+
+	var body map[string]any
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"host":{"id":"3Agent","name":"agent","status":"working","meta":{"agent-name":"mackerel-agent"},"interfaces":[{"name":"eth0","ipAddress":"192.0.2.1"}]}}`)
+		case http.MethodPut:
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+			fmt.Fprint(w, `{"id":"3Agent"}`)
+		default:
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+		}
+	}))
+
+	m := HostModel{
+		ID:     types.StringValue("3Agent"),
+		Name:   types.StringValue("agent"),
+		Memo:   types.StringValue("updated"),
+		Status: types.StringValue(mackerel.HostStatusWorking),
+	}
+	if err := m.Update(context.Background(), client); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if _, ok := body["checks"]; ok {
+		t.Errorf("expected checks not to be sent, but got: %+v", body["checks"])
+	}
+	if diff := cmp.Diff(map[string]any{"agent-name": "mackerel-agent"}, body["meta"]); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff([]any{map[string]any{"name": "eth0", "ipAddress": "192.0.2.1"}}, body["interfaces"]); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,94 @@
+package mackerel
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+type HostsModel struct {
+	ID               types.String          `tfsdk:"id"`
+	Service          types.String          `tfsdk:"service"`
+	RoleFullname     types.String          `tfsdk:"role_fullname"`
+	Name             types.String          `tfsdk:"name"`
+	Statuses         []string              `tfsdk:"statuses"`
+	CustomIdentifier types.String          `tfsdk:"custom_identifier"`
+	IDs              []string              `tfsdk:"ids"`
+	Hosts            []HostDataSourceModel `tfsdk:"hosts"`
+}
+
+// Reads hosts which match all of the filters in the config.
+func ReadHosts(ctx context.Context, client *Client, config HostsModel) (HostsModel, error) {
+	return readHostsInner(ctx, client, config)
+}
+
+type hostsFinder interface {
+	FindHostsContext(context.Context, *mackerel.FindHostsParam) ([]*mackerel.Host, error)
+}
+
+func readHostsInner(ctx context.Context, client hostsFinder, config HostsModel) (HostsModel, error) {
+	param := config.findHostsParam()
+	data := config
+	data.ID = types.StringValue(hostsID(param))
+
+	hosts, err := client.FindHostsContext(ctx, &param)
+	if err != nil {
+		return data, err
+	}
+
+	data.IDs = make([]string, 0, len(hosts))
+	data.Hosts = make([]HostDataSourceModel, 0, len(hosts))
+	for _, h := range hosts {
+		if h.IsRetired {
+			continue
+		}
+		data.IDs = append(data.IDs, h.ID)
+		data.Hosts = append(data.Hosts, newHostDataSource(*h))
+	}
+	return data, nil
+}
+
+func (m HostsModel) findHostsParam() mackerel.FindHostsParam {
+	param := mackerel.FindHostsParam{
+		Service:          m.Service.ValueString(),
+		Name:             m.Name.ValueString(),
+		Statuses:         m.Statuses,
+		CustomIdentifier: m.CustomIdentifier.ValueString(),
+	}
+	// Roles can be filtered only within a service.
+	if service, role, ok := strings.Cut(m.RoleFullname.ValueString(), ":"); ok {
+		param.Service = service
+		param.Roles = []string{role}
+	}
+	return param
+}
+
+// Identifies the data source by the filters, e.g. "name=foo&service=bar".
+func hostsID(param mackerel.FindHostsParam) string {
+	v := url.Values{}
+	if param.Service != "" {
+		v.Set("service", param.Service)
+	}
+	if len(param.Roles) > 0 {
+		v["role"] = param.Roles
+	}
+	if param.Name != "" {
+		v.Set("name", param.Name)
+	}
+	if len(param.Statuses) > 0 {
+		statuses := slices.Clone(param.Statuses)
+		slices.Sort(statuses)
+		v["status"] = statuses
+	}
+	if param.CustomIdentifier != "" {
+		v.Set("customIdentifier", param.CustomIdentifier)
+	}
+	if len(v) == 0 {
+		return "all"
+	}
+	return v.Encode()
+}
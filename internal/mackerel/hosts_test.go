@@ -0,0 +1,87 @@
+package mackerel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+func Test_Hosts_readHostsInner(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		in        HostsModel
+		wantParam mackerel.FindHostsParam
+		wantID    string
+	}{
+		"no filter": {
+			wantID: "all",
+		},
+		"role fullname": {
+			in: HostsModel{
+				RoleFullname: types.StringValue("network:router"),
+				Statuses:     []string{"working", "maintenance"},
+			},
+			wantParam: mackerel.FindHostsParam{
+				Service:  "network",
+				Roles:    []string{"router"},
+				Statuses: []string{"working", "maintenance"},
+			},
+			wantID: "role=router&service=network&status=maintenance&status=working",
+		},
+		"name and custom identifier": {
+			in: HostsModel{
+				Service:          types.StringValue("network"),
+				Name:             types.StringValue("core-router-01"),
+				CustomIdentifier: types.StringValue("core-router-01.example.com"),
+			},
+			wantParam: mackerel.FindHostsParam{
+				Service:          "network",
+				Name:             "core-router-01",
+				CustomIdentifier: "core-router-01.example.com",
+			},
+			wantID: "customIdentifier=core-router-01.example.com&name=core-router-01&service=network",
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotParam mackerel.FindHostsParam
+			client := hostsFinderFunc(func(param *mackerel.FindHostsParam) ([]*mackerel.Host, error) {
+				gotParam = *param
+				return []*mackerel.Host{
+					{ID: "3Working", Name: "working", Status: mackerel.HostStatusWorking},
+					{ID: "3Retired", Name: "retired", IsRetired: true},
+				}, nil
+			})
+
+			got, err := readHostsInner(context.Background(), client, tt.in)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if diff := cmp.Diff(tt.wantParam, gotParam); diff != "" {
+				t.Error(diff)
+			}
+			if got.ID.ValueString() != tt.wantID {
+				t.Errorf("expected the ID %q, but got %q", tt.wantID, got.ID.ValueString())
+			}
+			if diff := cmp.Diff([]string{"3Working"}, got.IDs); diff != "" {
+				t.Error(diff)
+			}
+			if len(got.Hosts) != 1 || got.Hosts[0].Name.ValueString() != "working" {
+				t.Errorf("unexpected hosts: %+v", got.Hosts)
+			}
+		})
+	}
+}
+
+type hostsFinderFunc func(*mackerel.FindHostsParam) ([]*mackerel.Host, error)
+
+func (f hostsFinderFunc) FindHostsContext(_ context.Context, param *mackerel.FindHostsParam) ([]*mackerel.Host, error) {
+	return f(param)
+}
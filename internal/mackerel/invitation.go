@@ -0,0 +1,178 @@
+package mackerel
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+// InvitationModel is an invitation to the organization, identified by the email.
+// The invitation is accepted when a user with the email joins the organization.
+type InvitationModel struct {
+	ID        types.String `tfsdk:"id"`
+	Email     types.String `tfsdk:"email"`
+	Authority types.String `tfsdk:"authority"`
+	ExpiresAt types.Int64  `tfsdk:"expires_at"`
+	Accepted  types.Bool   `tfsdk:"accepted"`
+}
+
+// Owners cannot be invited.
+func InvitationAuthorityValidator() validator.String {
+	return stringvalidator.OneOf("manager", "collaborator", "viewer")
+}
+
+func ImportInvitation(email string) InvitationModel {
+	return InvitationModel{
+		ID:    types.StringValue(email),
+		Email: types.StringValue(email),
+	}
+}
+
+// Reads an invitation. It is found while it is pending or after a user with the email has joined.
+// Expired or revoked invitations are not found.
+func ReadInvitation(ctx context.Context, client *Client, data InvitationModel) (InvitationModel, error) {
+	return readInvitationInner(ctx, client, data)
+}
+
+type invitationsFinder interface {
+	FindInvitationsContext(context.Context) ([]*mackerel.Invitation, error)
+}
+
+type invitationReader interface {
+	invitationsFinder
+	FindUsersContext(context.Context) ([]*mackerel.User, error)
+}
+
+func readInvitationInner(ctx context.Context, client invitationReader, data InvitationModel) (InvitationModel, error) {
+	email := data.Email.ValueString()
+	data.ID = types.StringValue(email)
+
+	invitation, err := findPendingInvitation(ctx, client, email)
+	if err != nil {
+		return InvitationModel{}, err
+	}
+	if invitation != nil {
+		data.Authority = types.StringValue(invitation.Authority)
+		data.ExpiresAt = types.Int64Value(invitation.ExpiresAt)
+		data.Accepted = types.BoolValue(false)
+		return data, nil
+	}
+
+	users, err := client.FindUsersContext(ctx)
+	if err != nil {
+		return InvitationModel{}, err
+	}
+	idx := slices.IndexFunc(users, func(u *mackerel.User) bool {
+		return strings.EqualFold(u.Email, email)
+	})
+	if idx == -1 {
+		return InvitationModel{}, newNotFoundError("the invitation for '%s' has expired or been revoked", email)
+	}
+	// The authority of the user may be changed after joining, which is not managed by the invitation.
+	if data.Authority.IsNull() || data.Authority.IsUnknown() {
+		data.Authority = types.StringValue(users[idx].Authority)
+	}
+	if data.ExpiresAt.IsUnknown() {
+		data.ExpiresAt = types.Int64Null()
+	}
+	data.Accepted = types.BoolValue(true)
+	return data, nil
+}
+
+// Sends an invitation. It fails if a user with the email has already joined the organization.
+func (m *InvitationModel) Create(ctx context.Context, client *Client) error {
+	return m.create(ctx, client)
+}
+
+type invitationCreator interface {
+	FindUsersContext(context.Context) ([]*mackerel.User, error)
+	CreateInvitationContext(context.Context, *mackerel.Invitation) (*mackerel.Invitation, error)
+}
+
+func (m *InvitationModel) create(ctx context.Context, client invitationCreator) error {
+	email := m.Email.ValueString()
+	users, err := client.FindUsersContext(ctx)
+	if err != nil {
+		return err
+	}
+	if slices.ContainsFunc(users, func(u *mackerel.User) bool {
+		return strings.EqualFold(u.Email, email)
+	}) {
+		return fmt.Errorf("a user with the email '%s' has already joined the organization, "+
+			"so the invitation cannot be sent. Import the accepted invitation instead", email)
+	}
+
+	invitation, err := client.CreateInvitationContext(ctx, &mackerel.Invitation{
+		Email:     m.Email.ValueString(),
+		Authority: m.Authority.ValueString(),
+	})
+	if err != nil {
+		return err
+	}
+	m.ID = m.Email
+	m.ExpiresAt = types.Int64Value(invitation.ExpiresAt)
+	m.Accepted = types.BoolValue(false)
+	return nil
+}
+
+// Reads an invitation.
+func (m *InvitationModel) Read(ctx context.Context, client *Client) error {
+	data, err := ReadInvitation(ctx, client, *m)
+	if err != nil {
+		return err
+	}
+	*m = data
+	return nil
+}
+
+// Revokes an invitation if it is still pending. Users who have already joined are kept.
+func (m InvitationModel) Delete(ctx context.Context, client *Client) error {
+	return deleteInvitationInner(ctx, client, m.Email.ValueString())
+}
+
+type invitationRevoker interface {
+	invitationsFinder
+	RevokeInvitationContext(context.Context, string) error
+}
+
+func deleteInvitationInner(ctx context.Context, client invitationRevoker, email string) error {
+	invitation, err := findPendingInvitation(ctx, client, email)
+	if err != nil {
+		return err
+	}
+	if invitation == nil {
+		return nil
+	}
+	return client.RevokeInvitationContext(ctx, invitation.Email)
+}
+
+// Revokes the pending invitation for the email.
+// mackerel-client-go does not have the method for the API yet.
+func (c *Client) RevokeInvitationContext(ctx context.Context, email string) error {
+	resp, err := c.PostJSONContext(ctx, "/api/v0/invitations/revoke", map[string]string{"email": email})
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// Returns nil if there is no pending invitation for the email.
+func findPendingInvitation(ctx context.Context, client invitationsFinder, email string) (*mackerel.Invitation, error) {
+	invitations, err := client.FindInvitationsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	idx := slices.IndexFunc(invitations, func(i *mackerel.Invitation) bool {
+		return strings.EqualFold(i.Email, email)
+	})
+	if idx == -1 {
+		return nil, nil
+	}
+	return invitations[idx], nil
+}
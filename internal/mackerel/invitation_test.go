@@ -0,0 +1,187 @@
+package mackerel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+func Test_Invitation_readInvitationInner(t *testing.T) {
+	t.Parallel()
+
+	client := invitationClientMock{
+		invitations: []*mackerel.Invitation{
+			{Email: "pending@example.com", Authority: "collaborator", ExpiresAt: 1700000000},
+		},
+		users: []*mackerel.User{
+			{ID: "user0", Email: "joined@example.com", Authority: "manager"},
+		},
+	}
+
+	cases := map[string]struct {
+		in           InvitationModel
+		wants        InvitationModel
+		wantNotFound bool
+	}{
+		"pending": {
+			in: ImportInvitation("pending@example.com"),
+			wants: InvitationModel{
+				ID:        types.StringValue("pending@example.com"),
+				Email:     types.StringValue("pending@example.com"),
+				Authority: types.StringValue("collaborator"),
+				ExpiresAt: types.Int64Value(1700000000),
+				Accepted:  types.BoolValue(false),
+			},
+		},
+		"accepted": {
+			in: InvitationModel{
+				ID:        types.StringValue("joined@example.com"),
+				Email:     types.StringValue("joined@example.com"),
+				Authority: types.StringValue("viewer"),
+				ExpiresAt: types.Int64Value(1700000000),
+				Accepted:  types.BoolValue(false),
+			},
+			wants: InvitationModel{
+				ID:        types.StringValue("joined@example.com"),
+				Email:     types.StringValue("joined@example.com"),
+				Authority: types.StringValue("viewer"),
+				ExpiresAt: types.Int64Value(1700000000),
+				Accepted:  types.BoolValue(true),
+			},
+		},
+		"imported after accepted": {
+			in: ImportInvitation("Joined@example.com"),
+			wants: InvitationModel{
+				ID:        types.StringValue("Joined@example.com"),
+				Email:     types.StringValue("Joined@example.com"),
+				Authority: types.StringValue("manager"),
+				Accepted:  types.BoolValue(true),
+			},
+		},
+		"expired": {
+			in:           ImportInvitation("expired@example.com"),
+			wantNotFound: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := readInvitationInner(context.Background(), client, tt.in)
+			if IsNotFound(err) != tt.wantNotFound {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.wants, got); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func Test_Invitation_deleteInvitationInner(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		inEmail     string
+		wantRevoked []string
+	}{
+		"pending": {
+			inEmail:     "pending@example.com",
+			wantRevoked: []string{"pending@example.com"},
+		},
+		"not pending": {
+			inEmail: "joined@example.com",
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &invitationClientMock{
+				invitations: []*mackerel.Invitation{
+					{Email: "pending@example.com", Authority: "viewer"},
+				},
+			}
+			if err := deleteInvitationInner(context.Background(), client, tt.inEmail); err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if diff := cmp.Diff(tt.wantRevoked, client.revoked); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func Test_Invitation_create(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		inEmail     string
+		wantErr     bool
+		wantInvited []string
+	}{
+		"new": {
+			inEmail:     "new@example.com",
+			wantInvited: []string{"new@example.com"},
+		},
+		"already joined": {
+			inEmail: "Joined@example.com",
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &invitationClientMock{
+				users: []*mackerel.User{
+					{ID: "user0", Email: "joined@example.com", Authority: "manager"},
+				},
+			}
+			m := InvitationModel{
+				Email:     types.StringValue(tt.inEmail),
+				Authority: types.StringValue("viewer"),
+			}
+			if err := m.create(context.Background(), client); (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if diff := cmp.Diff(tt.wantInvited, client.invited); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+type invitationClientMock struct {
+	invitations []*mackerel.Invitation
+	users       []*mackerel.User
+	invited     []string
+	revoked     []string
+}
+
+func (m invitationClientMock) FindInvitationsContext(context.Context) ([]*mackerel.Invitation, error) {
+	return m.invitations, nil
+}
+
+func (m invitationClientMock) FindUsersContext(context.Context) ([]*mackerel.User, error) {
+	return m.users, nil
+}
+
+func (m *invitationClientMock) RevokeInvitationContext(_ context.Context, email string) error {
+	m.revoked = append(m.revoked, email)
+	return nil
+}
+
+func (m *invitationClientMock) CreateInvitationContext(_ context.Context, param *mackerel.Invitation) (*mackerel.Invitation, error) {
+	m.invited = append(m.invited, param.Email)
+	return &mackerel.Invitation{Email: param.Email, Authority: param.Authority, ExpiresAt: 1700000000}, nil
+}
@@ -0,0 +1,172 @@
+package mackerel
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	logSubsystem = "api"
+	redacted     = "***"
+)
+
+// Keys of JSON fields which hold secrets, in request and response bodies.
+var secretJSONKeys = map[string]bool{
+	"secretKey":  true, // AWS integration
+	"secret_key": true,
+	"headers":    true, // webhook channels and external monitors
+}
+
+// Headers which hold secrets.
+var secretHeaders = []string{"X-Api-Key", "Authorization", "Proxy-Authorization"}
+
+// loggingTransport writes requests and responses to the provider logs.
+// Secrets are redacted before they are written.
+//
+// Summaries are written at DEBUG level, and headers and bodies at TRACE level.
+type loggingTransport struct {
+	base http.RoundTripper
+	// Masked wherever they appear, in case they leak through unknown fields.
+	apiKeys []string
+}
+
+var _ http.RoundTripper = (*loggingTransport)(nil)
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), logSubsystem,
+		tflog.WithLevelFromEnv("TF_LOG_PROVIDER_MACKEREL", logSubsystem),
+		tflog.WithRootFields(),
+	)
+	for _, key := range t.apiKeys {
+		if key != "" {
+			ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, logSubsystem, key)
+			ctx = tflog.SubsystemMaskMessageStrings(ctx, logSubsystem, key)
+		}
+	}
+	ctx = tflog.SubsystemSetField(ctx, logSubsystem, "method", req.Method)
+	ctx = tflog.SubsystemSetField(ctx, logSubsystem, "path", req.URL.Path)
+
+	fields := map[string]any{
+		"headers": redactHeaders(req.Header),
+	}
+	if req.Body != nil && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			b, _ := io.ReadAll(body)
+			body.Close()
+			fields["body"] = redactJSON(b)
+		}
+	}
+	tflog.SubsystemTrace(ctx, logSubsystem, "Sending HTTP request", fields)
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	latency := time.Since(start)
+	if err != nil {
+		tflog.SubsystemDebug(ctx, logSubsystem, "HTTP request failed", map[string]any{
+			"latency_ms": latency.Milliseconds(),
+			"error":      err.Error(),
+		})
+		return nil, err
+	}
+
+	tflog.SubsystemDebug(ctx, logSubsystem, "Received HTTP response", map[string]any{
+		"status":     resp.StatusCode,
+		"latency_ms": latency.Milliseconds(),
+		"request_id": requestID(resp.Header),
+	})
+
+	fields = map[string]any{
+		"status":  resp.StatusCode,
+		"headers": redactHeaders(resp.Header),
+	}
+	if resp.Body != nil {
+		b, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		var body io.Reader = bytes.NewReader(b)
+		if readErr != nil {
+			// Let the caller see the same error as without logging.
+			body = io.MultiReader(body, errReader{readErr})
+		}
+		resp.Body = io.NopCloser(body)
+		fields["body"] = redactJSON(b)
+	}
+	tflog.SubsystemTrace(ctx, logSubsystem, "Received HTTP response body", fields)
+
+	return resp, nil
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+func requestID(h http.Header) string {
+	return h.Get("X-Request-Id")
+}
+
+func redactHeaders(h http.Header) map[string]string {
+	m := make(map[string]string, len(h))
+	for name, values := range h {
+		m[name] = strings.Join(values, ", ")
+	}
+	for _, name := range secretHeaders {
+		if _, ok := m[http.CanonicalHeaderKey(name)]; ok {
+			m[http.CanonicalHeaderKey(name)] = redacted
+		}
+	}
+	return m
+}
+
+// Returns the body with secret fields redacted.
+// Bodies which are not JSON are not written at all, since they cannot be redacted.
+func redactJSON(b []byte) string {
+	if len(bytes.TrimSpace(b)) == 0 {
+		return ""
+	}
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return "(non-JSON body is omitted)"
+	}
+	out, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return "(unprintable body is omitted)"
+	}
+	return string(out)
+}
+
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if secretJSONKeys[key] {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(value)
+		}
+		// Slack channels carry the webhook URL, which works as a credential.
+		if v["type"] == "slack" {
+			if _, ok := v["url"]; ok {
+				v["url"] = redacted
+			}
+		}
+		return v
+	case []any:
+		for i, value := range v {
+			v[i] = redactValue(value)
+		}
+		return v
+	case string:
+		if strings.HasPrefix(v, "https://hooks.slack.com/") {
+			return redacted
+		}
+		return v
+	default:
+		return v
+	}
+}
@@ -0,0 +1,130 @@
+package mackerel
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func Test_redactJSON(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		in   string
+		want string
+	}{
+		"empty": {
+			in:   "",
+			want: "",
+		},
+		"not JSON": {
+			in:   "secret",
+			want: "(non-JSON body is omitted)",
+		},
+		"AWS integration": {
+			in:   `{"name":"aws","key":"AKIA","secretKey":"s3cr3t"}`,
+			want: `{"key":"AKIA","name":"aws","secretKey":"***"}`,
+		},
+		"slack channel": {
+			in:   `{"channels":[{"type":"slack","name":"ch","url":"https://hooks.slack.test/xxx"}]}`,
+			want: `{"channels":[{"name":"ch","type":"slack","url":"***"}]}`,
+		},
+		"slack URL anywhere": {
+			in:   `{"memo":"https://hooks.slack.com/services/xxx"}`,
+			want: `{"memo":"***"}`,
+		},
+		"external monitor headers": {
+			in:   `{"type":"external","url":"https://example.test/","headers":[{"name":"Authorization","value":"Bearer x"}]}`,
+			want: `{"headers":"***","type":"external","url":"https://example.test/"}`,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(tt.want, redactJSON([]byte(tt.in))); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func Test_loggingTransport(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-1")
+		fmt.Fprint(w, `{"id":"aws0","secretKey":"response-secret"}`)
+	}))
+	t.Cleanup(ts.Close)
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(t.Context(), &logs)
+
+	client := &http.Client{Transport: &loggingTransport{base: http.DefaultTransport, apiKeys: []string{"the-api-key"}}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ts.URL+"/api/v0/aws-integrations", strings.NewReader(`{"secretKey":"request-secret","memo":"the-api-key"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("X-Api-Key", "the-api-key")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if diff := cmp.Diff(`{"id":"aws0","secretKey":"response-secret"}`, string(body)); diff != "" {
+		t.Errorf("response body is changed: %s", diff)
+	}
+
+	out := logs.String()
+	if !strings.Contains(out, "Sending HTTP request") {
+		t.Errorf("request is not logged: %s", out)
+	}
+	for _, secret := range []string{"the-api-key", "request-secret", "response-secret"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("%q is leaked into logs: %s", secret, out)
+		}
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&logs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var summary map[string]any
+	for _, e := range entries {
+		if e["@message"] == "Received HTTP response" {
+			summary = e
+		}
+	}
+	if summary == nil {
+		t.Fatalf("no summary is logged: %s", out)
+	}
+	for key, want := range map[string]any{
+		"method":     http.MethodPost,
+		"path":       "/api/v0/aws-integrations",
+		"status":     json.Number("200"),
+		"request_id": "req-1",
+	} {
+		got := summary[key]
+		if n, ok := got.(float64); ok {
+			got = json.Number(fmt.Sprint(n))
+		}
+		if got != want {
+			t.Errorf("expected %s to be %v, but got %v", key, want, got)
+		}
+	}
+	if _, ok := summary["latency_ms"]; !ok {
+		t.Error("latency_ms is not logged")
+	}
+}
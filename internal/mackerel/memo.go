@@ -0,0 +1,110 @@
+package mackerel
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Memos of monitors, dashboards, downtimes and alert group settings end with a footer line
+// which holds the metadata of the provider, since these objects have no place for tags:
+//
+//	This is the memo written by users.
+//
+//	[terraform-provider-mackerel] {"managed_by":"terraform","labels":{"env":"production"}}
+//
+// The footer is added on writes and removed on reads, so it never appears in `memo` attributes.
+const memoFooterPrefix = "[terraform-provider-mackerel] "
+
+type memoFooter struct {
+	ManagedBy string            `json:"managed_by,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+// Appends the footer to the memo. The memo is returned as is if there is nothing to add.
+func joinMemo(memo, managedBy string, labels map[string]string) string {
+	if managedBy == "" && len(labels) == 0 {
+		return memo
+	}
+
+	// Marshaling a map of strings never fails.
+	footer, _ := json.Marshal(memoFooter{ManagedBy: managedBy, Labels: labels})
+	if memo == "" {
+		return memoFooterPrefix + string(footer)
+	}
+	return memo + "\n\n" + memoFooterPrefix + string(footer)
+}
+
+// Splits the memo into the part written by users and the labels in the footer.
+// Labels are nil if the memo has no footer.
+func splitMemo(memo string) (string, map[string]string) {
+	var body, line string
+	if strings.HasPrefix(memo, memoFooterPrefix) {
+		line = memo
+	} else if i := strings.LastIndex(memo, "\n\n"+memoFooterPrefix); i >= 0 {
+		body, line = memo[:i], memo[i+2:]
+	} else {
+		return memo, nil
+	}
+	if strings.Contains(line, "\n") {
+		// The footer must be the last line.
+		return memo, nil
+	}
+
+	var footer memoFooter
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(line, memoFooterPrefix)), &footer); err != nil {
+		return memo, nil
+	}
+	return body, footer.Labels
+}
+
+// splitMemo for types.String. A null memo is returned as is.
+func splitMemoValue(memo types.String) (types.String, map[string]string) {
+	if memo.IsNull() || memo.IsUnknown() {
+		return memo, nil
+	}
+	body, labels := splitMemo(memo.ValueString())
+	return types.StringValue(body), labels
+}
+
+// Reports whether `labels` has all of `want`.
+func hasLabels(labels, want map[string]string) bool {
+	for k, v := range want {
+		if got, ok := labels[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the only item which has all of the labels.
+func findByLabels[T any](kind string, items []T, labels func(T) map[string]string, want map[string]string) (T, error) {
+	var found []T
+	for _, item := range items {
+		if hasLabels(labels(item), want) {
+			found = append(found, item)
+		}
+	}
+
+	var zero T
+	switch len(found) {
+	case 1:
+		return found[0], nil
+	case 0:
+		return zero, newNotFoundError("no %s has the labels %s", kind, formatLabels(want))
+	default:
+		return zero, fmt.Errorf("%d %ss have the labels %s, but exactly one is expected", len(found), kind, formatLabels(want))
+	}
+}
+
+func formatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for _, k := range slices.Sorted(maps.Keys(labels)) {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", k, labels[k]))
+	}
+	return "{" + strings.Join(pairs, ", ") + "}"
+}
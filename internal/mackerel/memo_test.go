@@ -0,0 +1,157 @@
+package mackerel
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_joinMemo(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		memo      string
+		managedBy string
+		labels    map[string]string
+		want      string
+	}{
+		"nothing to add": {
+			memo: "memo",
+			want: "memo",
+		},
+		"labels": {
+			memo:   "memo",
+			labels: map[string]string{"env": "production", "team": "sre"},
+			want:   "memo\n\n" + `[terraform-provider-mackerel] {"labels":{"env":"production","team":"sre"}}`,
+		},
+		"managed marker": {
+			memo:      "memo",
+			managedBy: "terraform",
+			want:      "memo\n\n" + `[terraform-provider-mackerel] {"managed_by":"terraform"}`,
+		},
+		"empty memo": {
+			managedBy: "terraform",
+			labels:    map[string]string{"env": "production"},
+			want:      `[terraform-provider-mackerel] {"managed_by":"terraform","labels":{"env":"production"}}`,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := joinMemo(tt.memo, tt.managedBy, tt.labels)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Error(diff)
+			}
+
+			body, labels := splitMemo(got)
+			if body != tt.memo {
+				t.Errorf("expected the memo %q, but got %q", tt.memo, body)
+			}
+			if diff := cmp.Diff(tt.labels, labels); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func Test_splitMemo(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		in         string
+		wantMemo   string
+		wantLabels map[string]string
+	}{
+		"no footer": {
+			in:       "memo\n\nmore memo",
+			wantMemo: "memo\n\nmore memo",
+		},
+		"multiline memo": {
+			in:         "line 1\nline 2\n\n" + `[terraform-provider-mackerel] {"labels":{"env":"staging"}}`,
+			wantMemo:   "line 1\nline 2",
+			wantLabels: map[string]string{"env": "staging"},
+		},
+		"invalid footer": {
+			in:       "memo\n\n[terraform-provider-mackerel] {",
+			wantMemo: "memo\n\n[terraform-provider-mackerel] {",
+		},
+		"not the last line": {
+			in:       "memo\n\n" + `[terraform-provider-mackerel] {}` + "\nmore memo",
+			wantMemo: "memo\n\n" + `[terraform-provider-mackerel] {}` + "\nmore memo",
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			memo, labels := splitMemo(tt.in)
+			if diff := cmp.Diff(tt.wantMemo, memo); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(tt.wantLabels, labels); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func Test_findByLabels(t *testing.T) {
+	t.Parallel()
+
+	items := []map[string]string{
+		{"env": "production", "team": "sre"},
+		{"env": "staging", "team": "sre"},
+		nil,
+	}
+	labels := func(m map[string]string) map[string]string { return m }
+
+	cases := map[string]struct {
+		want         map[string]string
+		wantIdx      int
+		wantErr      bool
+		wantNotFound bool
+	}{
+		"found": {
+			want:    map[string]string{"env": "staging"},
+			wantIdx: 1,
+		},
+		"all labels": {
+			want:    map[string]string{"env": "production", "team": "sre"},
+			wantIdx: 0,
+		},
+		"not found": {
+			want:         map[string]string{"env": "development"},
+			wantErr:      true,
+			wantNotFound: true,
+		},
+		"ambiguous": {
+			want:    map[string]string{"team": "sre"},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := findByLabels("item", items, labels, tt.want)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			var nf *NotFoundError
+			if errors.As(err, &nf) != tt.wantNotFound {
+				t.Errorf("unexpected error: %+v", err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(items[tt.wantIdx], got); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -18,6 +18,8 @@ type MonitorModel struct {
 	Memo                 types.String `tfsdk:"memo"`
 	IsMute               types.Bool   `tfsdk:"is_mute"`
 	NotificationInterval types.Int64  `tfsdk:"notification_interval"`
+	// Stored in the footer of the memo.
+	Labels map[string]string `tfsdk:"labels"`
 	// #region: one-of
 	HostMetricMonitor       []MonitorHostMetric       `tfsdk:"host_metric"`
 	ServiceMetricMonitor    []MonitorServiceMetric    `tfsdk:"service_metric"`
@@ -102,17 +104,43 @@ type MonitorAnomalyDetection struct {
 }
 
 // Reads the monitor by the id.
-// Currently non-cancelable.
-func ReadMonitor(_ context.Context, client *Client, id string) (MonitorModel, error) {
-	m, err := client.GetMonitor(id)
+func ReadMonitor(ctx context.Context, client *Client, id string) (MonitorModel, error) {
+	m, err := client.GetMonitorContext(ctx, id)
+	if err != nil {
+		return MonitorModel{}, wrapNotFound(err)
+	}
+	model, err := newMonitor(m)
 	if err != nil {
 		return MonitorModel{}, err
 	}
-	return newMonitor(m)
+	model.Name = client.trimNamePrefix(model.Name)
+	return model, nil
+}
+
+// Finds the only monitor which has all of the labels.
+func FindMonitorByLabels(ctx context.Context, client *Client, labels map[string]string) (MonitorModel, error) {
+	monitors, err := client.FindMonitorsContext(ctx)
+	if err != nil {
+		return MonitorModel{}, err
+	}
+	models := make([]MonitorModel, 0, len(monitors))
+	for _, m := range monitors {
+		model, err := newMonitor(m)
+		if err != nil {
+			// Monitors of unsupported types cannot be read anyway.
+			continue
+		}
+		model.Name = client.trimNamePrefix(model.Name)
+		models = append(models, model)
+	}
+	return findByLabels("monitor", models, func(m MonitorModel) map[string]string { return m.Labels }, labels)
 }
 
 func (m *MonitorModel) Create(ctx context.Context, client *Client) error {
-	monitor, err := client.CreateMonitor(m.mackerelMonitor())
+	param := *m
+	param.Name = client.prefixName(m.Name)
+	param.Memo = types.StringValue(joinMemo(m.Memo.ValueString(), client.managedMarker, m.Labels))
+	monitor, err := client.CreateMonitorContext(ctx, param.mackerelMonitor())
 	if err != nil {
 		return err
 	}
@@ -132,14 +160,17 @@ func (m *MonitorModel) Read(ctx context.Context, client *Client) error {
 }
 
 func (m MonitorModel) Update(ctx context.Context, client *Client) error {
-	if _, err := client.UpdateMonitor(m.ID.ValueString(), m.mackerelMonitor()); err != nil {
+	param := m
+	param.Name = client.prefixName(m.Name)
+	param.Memo = types.StringValue(joinMemo(m.Memo.ValueString(), client.managedMarker, m.Labels))
+	if _, err := client.UpdateMonitorContext(ctx, m.ID.ValueString(), param.mackerelMonitor()); err != nil {
 		return err
 	}
 	return nil
 }
 
 func (m MonitorModel) Delete(ctx context.Context, client *Client) error {
-	if _, err := client.DeleteMonitor(m.ID.ValueString()); err != nil {
+	if _, err := client.DeleteMonitorContext(ctx, m.ID.ValueString()); err != nil {
 		return err
 	}
 	return nil
@@ -302,6 +333,7 @@ func newMonitor(mackerelMonitor mackerel.Monitor) (MonitorModel, error) {
 	default:
 		return model, fmt.Errorf("unimplemented type: %s", mackerelMonitor.MonitorType())
 	}
+	model.Memo, model.Labels = splitMemoValue(model.Memo)
 
 	return model, nil
 }
@@ -0,0 +1,27 @@
+package mackerel
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Names (or titles) of monitors, dashboards, downtimes, channels, notification groups and alert group settings
+// are prefixed with `name_prefix` in Mackerel, so that stacks of several environments can share an organization.
+// The prefix is added on writes and removed on reads, so it never appears in `name` attributes.
+
+// Prepends the name prefix to the name.
+func (c *Client) prefixName(name types.String) types.String {
+	if c.namePrefix == "" || name.IsNull() || name.IsUnknown() {
+		return name
+	}
+	return types.StringValue(c.namePrefix + name.ValueString())
+}
+
+// Removes the name prefix from the name. Names without the prefix are returned as is.
+func (c *Client) trimNamePrefix(name types.String) types.String {
+	if c.namePrefix == "" || name.IsNull() || name.IsUnknown() {
+		return name
+	}
+	return types.StringValue(strings.TrimPrefix(name.ValueString(), c.namePrefix))
+}
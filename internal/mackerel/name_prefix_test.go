@@ -0,0 +1,57 @@
+package mackerel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Test_Client_namePrefix(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		prefix      string
+		in          types.String
+		wantPrefix  types.String
+		wantTrimmed types.String
+	}{
+		"no prefix": {
+			in:          types.StringValue("name"),
+			wantPrefix:  types.StringValue("name"),
+			wantTrimmed: types.StringValue("name"),
+		},
+		"prefix": {
+			prefix:      "pr-123-",
+			in:          types.StringValue("name"),
+			wantPrefix:  types.StringValue("pr-123-name"),
+			wantTrimmed: types.StringValue("name"),
+		},
+		"null": {
+			prefix:      "pr-123-",
+			in:          types.StringNull(),
+			wantPrefix:  types.StringNull(),
+			wantTrimmed: types.StringNull(),
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &Client{namePrefix: tt.prefix}
+			prefixed := client.prefixName(tt.in)
+			if prefixed != tt.wantPrefix {
+				t.Errorf("expected %s, but got %s", tt.wantPrefix, prefixed)
+			}
+			if trimmed := client.trimNamePrefix(prefixed); trimmed != tt.wantTrimmed {
+				t.Errorf("expected %s, but got %s", tt.wantTrimmed, trimmed)
+			}
+		})
+	}
+
+	// Objects created without the prefix are read as is.
+	client := &Client{namePrefix: "pr-123-"}
+	if got := client.trimNamePrefix(types.StringValue("production")); got != types.StringValue("production") {
+		t.Errorf("expected production, but got %s", got)
+	}
+}
@@ -37,15 +37,20 @@ func NotificationLevelValidator() validator.String {
 
 // Reads a notification group by `id`
 func ReadNotificationGroup(ctx context.Context, client *Client, id string) (NotificationGroupModel, error) {
-	return readNotificationGroupInner(ctx, client, id)
+	model, err := readNotificationGroupInner(ctx, client, id)
+	if err != nil {
+		return NotificationGroupModel{}, err
+	}
+	model.Name = client.trimNamePrefix(model.Name)
+	return model, nil
 }
 
 type notificationGroupFinder interface {
-	FindNotificationGroups() ([]*mackerel.NotificationGroup, error)
+	FindNotificationGroupsContext(context.Context) ([]*mackerel.NotificationGroup, error)
 }
 
-func readNotificationGroupInner(_ context.Context, client notificationGroupFinder, id string) (NotificationGroupModel, error) {
-	ngs, err := client.FindNotificationGroups()
+func readNotificationGroupInner(ctx context.Context, client notificationGroupFinder, id string) (NotificationGroupModel, error) {
+	ngs, err := client.FindNotificationGroupsContext(ctx)
 	if err != nil {
 		return NotificationGroupModel{}, err
 	}
@@ -54,7 +59,7 @@ func readNotificationGroupInner(_ context.Context, client notificationGroupFinde
 		return ng.ID == id
 	})
 	if ngIdx < 0 {
-		return NotificationGroupModel{}, fmt.Errorf("the ID '%s' does not match any notification group in mackerel.io", id)
+		return NotificationGroupModel{}, newNotFoundError("the ID '%s' does not match any notification group in mackerel.io", id)
 	}
 
 	return newNotificationGroupModel(*ngs[ngIdx]), nil
@@ -62,16 +67,22 @@ func readNotificationGroupInner(_ context.Context, client notificationGroupFinde
 
 // Creates a notification group
 func (m *NotificationGroupModel) Create(ctx context.Context, client *Client) error {
-	return m.createInner(ctx, client)
+	param := *m
+	param.Name = client.prefixName(m.Name)
+	if err := param.createInner(ctx, client); err != nil {
+		return err
+	}
+	m.ID = param.ID
+	return nil
 }
 
 type notificationGroupCreator interface {
-	CreateNotificationGroup(*mackerel.NotificationGroup) (*mackerel.NotificationGroup, error)
+	CreateNotificationGroupContext(context.Context, *mackerel.NotificationGroup) (*mackerel.NotificationGroup, error)
 }
 
-func (m *NotificationGroupModel) createInner(_ context.Context, client notificationGroupCreator) error {
+func (m *NotificationGroupModel) createInner(ctx context.Context, client notificationGroupCreator) error {
 	param := m.mackerelNotificationGroup()
-	ng, err := client.CreateNotificationGroup(&param)
+	ng, err := client.CreateNotificationGroupContext(ctx, &param)
 	if err != nil {
 		return err
 	}
@@ -97,17 +108,19 @@ func (m *NotificationGroupModel) Read(ctx context.Context, client *Client) error
 }
 
 // Updates the notification group
-func (m *NotificationGroupModel) Update(_ context.Context, client *Client) error {
-	param := m.mackerelNotificationGroup()
-	if _, err := client.UpdateNotificationGroup(m.ID.ValueString(), &param); err != nil {
+func (m *NotificationGroupModel) Update(ctx context.Context, client *Client) error {
+	withPrefix := *m
+	withPrefix.Name = client.prefixName(m.Name)
+	param := withPrefix.mackerelNotificationGroup()
+	if _, err := client.UpdateNotificationGroupContext(ctx, m.ID.ValueString(), &param); err != nil {
 		return err
 	}
 	return nil
 }
 
 // Deletes the notification group
-func (m *NotificationGroupModel) Delete(_ context.Context, client *Client) error {
-	if _, err := client.DeleteNotificationGroup(m.ID.ValueString()); err != nil {
+func (m *NotificationGroupModel) Delete(ctx context.Context, client *Client) error {
+	if _, err := client.DeleteNotificationGroupContext(ctx, m.ID.ValueString()); err != nil {
 		return err
 	}
 	return nil
@@ -83,7 +83,7 @@ func Test_NotificationGroup_Read(t *testing.T) {
 
 type notificationGroupFinderFunc func() ([]*mackerel.NotificationGroup, error)
 
-func (f notificationGroupFinderFunc) FindNotificationGroups() ([]*mackerel.NotificationGroup, error) {
+func (f notificationGroupFinderFunc) FindNotificationGroupsContext(_ context.Context) ([]*mackerel.NotificationGroup, error) {
 	return f()
 }
 
@@ -167,7 +167,7 @@ type notificationGroupCreatorTester struct {
 	Request mackerel.NotificationGroup
 }
 
-func (ct *notificationGroupCreatorTester) CreateNotificationGroup(param *mackerel.NotificationGroup) (*mackerel.NotificationGroup, error) {
+func (ct *notificationGroupCreatorTester) CreateNotificationGroupContext(_ context.Context, param *mackerel.NotificationGroup) (*mackerel.NotificationGroup, error) {
 	ct.Request = *param
 	data := *param
 	data.ID = ct.ID
@@ -0,0 +1,93 @@
+package mackerel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+const cacheKeyOrg = "org"
+
+func (c *Client) GetOrgContext(ctx context.Context) (*mackerel.Org, error) {
+	return cachedList(ctx, c.orgCache, cacheKeyOrg, c.Client.GetOrgContext)
+}
+
+// Returns the name of the organization which the API key belongs to.
+func (c *Client) OrgName(ctx context.Context) (string, error) {
+	org, err := c.GetOrgContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	return org.Name, nil
+}
+
+// Fails if the API key does not belong to the expected organization.
+func (c *Client) VerifyOrg(ctx context.Context, expected string) error {
+	name, err := c.OrgName(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get the organization: %w", err)
+	}
+	if name != expected {
+		return fmt.Errorf("the API key belongs to the organization %q, but expected_organization is %q", name, expected)
+	}
+	return nil
+}
+
+type OrganizationModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	DisplayName types.String `tfsdk:"display_name"`
+}
+
+// Reads the organization which the API key belongs to.
+func ReadOrganization(ctx context.Context, client *Client) (OrganizationModel, error) {
+	return readOrganizationInner(ctx, client)
+}
+
+type orgGetter interface {
+	GetOrgContext(context.Context) (*mackerel.Org, error)
+}
+
+func readOrganizationInner(ctx context.Context, client orgGetter) (OrganizationModel, error) {
+	org, err := client.GetOrgContext(ctx)
+	if err != nil {
+		return OrganizationModel{}, err
+	}
+	return OrganizationModel{
+		ID:          types.StringValue(org.Name),
+		Name:        types.StringValue(org.Name),
+		DisplayName: types.StringValue(org.DisplayName),
+	}, nil
+}
+
+// writeLogTransport logs every write request with the organization which it is sent to.
+type writeLogTransport struct {
+	base    http.RoundTripper
+	orgName func(context.Context) (string, error)
+}
+
+var _ http.RoundTripper = (*writeLogTransport)(nil)
+
+func (t *writeLogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+	default:
+		ctx := req.Context()
+		fields := map[string]any{
+			"method": req.Method,
+			"path":   req.URL.Path,
+		}
+		// Reading the organization is a GET request, so it never comes back here.
+		if name, err := t.orgName(ctx); err == nil {
+			fields["organization"] = name
+		} else {
+			tflog.Warn(ctx, "Unable to get the organization", map[string]any{"error": err.Error()})
+		}
+		tflog.Info(ctx, "Writing to Mackerel", fields)
+	}
+	return t.base.RoundTrip(req)
+}
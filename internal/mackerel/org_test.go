@@ -0,0 +1,132 @@
+package mackerel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+func Test_Client_VerifyOrg(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	requests := 0
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"name":"staging"}`)
+	}))
+
+	if err := client.VerifyOrg(t.Context(), "staging"); err != nil {
+		t.Errorf("unexpected error: %+v", err)
+	}
+	if err := client.VerifyOrg(t.Context(), "production"); err == nil {
+		t.Error("expected error, but got no error")
+	}
+	if requests != 1 {
+		t.Errorf("expected to get the organization once, but got %d times", requests)
+	}
+}
+
+func Test_writeLogTransport(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	requests := map[string]int{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.Method+" "+r.URL.Path]++
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v0/org":
+			fmt.Fprint(w, `{"name":"staging"}`)
+		case "/api/v0/services":
+			fmt.Fprint(w, `{"services":[],"name":"svc","memo":"","roles":[]}`)
+		}
+	}))
+	t.Cleanup(ts.Close)
+
+	c, err := mackerel.NewClientWithOptions("api-key", ts.URL, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := newClient(c)
+	c.HTTPClient.Transport = &writeLogTransport{base: http.DefaultTransport, orgName: client.OrgName}
+
+	for range 2 {
+		if _, err := client.FindServicesContext(t.Context()); err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if _, err := client.CreateServiceContext(t.Context(), &mackerel.CreateServiceParam{Name: "svc"}); err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+	}
+
+	if got := requests["GET /api/v0/org"]; got != 1 {
+		t.Errorf("expected to get the organization once, but got %d times", got)
+	}
+	if got := requests["POST /api/v0/services"]; got != 2 {
+		t.Errorf("expected to create services twice, but got %d times", got)
+	}
+}
+
+func Test_ReadOrganization(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		in    mackerel.Org
+		wants OrganizationModel
+	}{
+		"basic": {
+			in: mackerel.Org{Name: "example", DisplayName: "Example Inc."},
+			wants: OrganizationModel{
+				ID:          types.StringValue("example"),
+				Name:        types.StringValue("example"),
+				DisplayName: types.StringValue("Example Inc."),
+			},
+		},
+		"no display name": {
+			in: mackerel.Org{Name: "example"},
+			wants: OrganizationModel{
+				ID:          types.StringValue("example"),
+				Name:        types.StringValue("example"),
+				DisplayName: types.StringValue(""),
+			},
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := orgGetterFunc(func() (*mackerel.Org, error) {
+				return &tt.in, nil
+			})
+			data, err := readOrganizationInner(context.Background(), client)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if diff := cmp.Diff(tt.wants, data); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+type orgGetterFunc func() (*mackerel.Org, error)
+
+func (f orgGetterFunc) GetOrgContext(context.Context) (*mackerel.Org, error) {
+	return f()
+}
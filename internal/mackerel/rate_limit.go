@@ -0,0 +1,89 @@
+package mackerel
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket which is refilled at a constant interval.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration // to refill one token
+	burst    float64
+	tokens   float64
+	last     time.Time
+	now      func() time.Time
+}
+
+// Creates a limiter which allows `requestsPerMinute` requests per minute.
+// Up to one second's worth of requests can be sent at once.
+func newRateLimiter(requestsPerMinute int) *rateLimiter {
+	burst := float64(max(requestsPerMinute/60, 1))
+	return &rateLimiter{
+		interval: time.Minute / time.Duration(requestsPerMinute),
+		burst:    burst,
+		tokens:   burst,
+		now:      time.Now,
+	}
+}
+
+// Takes a token and returns the duration to wait for it.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens = min(l.burst, l.tokens+float64(now.Sub(l.last))/float64(l.interval))
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens * float64(l.interval))
+}
+
+// Gives back a token which is not used.
+func (l *rateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens = min(l.burst, l.tokens+1)
+}
+
+// Blocks until a request is allowed or ctx is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	wait := l.reserve()
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rateLimitTransport throttles all requests sent through the client.
+// Since the provider shares one client, the limit applies to all resources and data sources.
+type rateLimitTransport struct {
+	base    http.RoundTripper
+	limiter *rateLimiter
+}
+
+var _ http.RoundTripper = (*rateLimitTransport)(nil)
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,96 @@
+package mackerel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Test_rateLimiter_reserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newRateLimiter(120) // 2 requests per second
+	l.now = func() time.Time { return now }
+
+	steps := []struct {
+		advance time.Duration
+		want    time.Duration
+	}{
+		// burst
+		{advance: 0, want: 0},
+		{advance: 0, want: 0},
+		// exhausted
+		{advance: 0, want: 500 * time.Millisecond},
+		{advance: 0, want: 1000 * time.Millisecond},
+		// refilled
+		{advance: 2 * time.Second, want: 0},
+		{advance: 0, want: 0},
+		{advance: 0, want: 500 * time.Millisecond},
+		// never exceeds the burst
+		{advance: time.Hour, want: 0},
+		{advance: 0, want: 0},
+		{advance: 0, want: 500 * time.Millisecond},
+	}
+
+	for i, step := range steps {
+		now = now.Add(step.advance)
+		if got := l.reserve(); got != step.want {
+			t.Errorf("step %d: expected %s, but got %s", i, step.want, got)
+		}
+	}
+}
+
+func Test_rateLimiter_Wait_canceled(t *testing.T) {
+	t.Parallel()
+
+	l := newRateLimiter(1)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); err == nil {
+		t.Error("expected error, but got no error")
+	}
+}
+
+func Test_ClientConfig_rateLimit(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		in          types.Int64
+		wantLimiter bool
+		wantErr     bool
+	}{
+		"unlimited": {
+			in: types.Int64Null(),
+		},
+		"limited": {
+			in:          types.Int64Value(300),
+			wantLimiter: true,
+		},
+		"zero": {
+			in:      types.Int64Value(0),
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := ClientConfigModel{RequestsPerMinute: tt.in}
+			limiter, err := config.newRateLimiter()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("unexpected error: %+v", err)
+			}
+			if (limiter != nil) != tt.wantLimiter {
+				t.Errorf("expected limiter: %t, but got: %+v", tt.wantLimiter, limiter)
+			}
+		})
+	}
+}
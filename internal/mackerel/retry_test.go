@@ -0,0 +1,245 @@
+package mackerel
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Test_retryTransport(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		method   string
+		statuses []int
+
+		wantStatus   int
+		wantAttempts int32
+	}{
+		"success": {
+			method:   http.MethodGet,
+			statuses: []int{http.StatusOK},
+
+			wantStatus:   http.StatusOK,
+			wantAttempts: 1,
+		},
+		"GET retries on 503": {
+			method:   http.MethodGet,
+			statuses: []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+
+			wantStatus:   http.StatusOK,
+			wantAttempts: 3,
+		},
+		"PUT retries on 500": {
+			method:   http.MethodPut,
+			statuses: []int{http.StatusInternalServerError, http.StatusOK},
+
+			wantStatus:   http.StatusOK,
+			wantAttempts: 2,
+		},
+		"POST retries on 429": {
+			method:   http.MethodPost,
+			statuses: []int{http.StatusTooManyRequests, http.StatusOK},
+
+			wantStatus:   http.StatusOK,
+			wantAttempts: 2,
+		},
+		"POST does not retry on 503": {
+			method:   http.MethodPost,
+			statuses: []int{http.StatusServiceUnavailable, http.StatusOK},
+
+			wantStatus:   http.StatusServiceUnavailable,
+			wantAttempts: 1,
+		},
+		"no retry on 4xx": {
+			method:   http.MethodGet,
+			statuses: []int{http.StatusBadRequest, http.StatusOK},
+
+			wantStatus:   http.StatusBadRequest,
+			wantAttempts: 1,
+		},
+		"gives up": {
+			method: http.MethodDelete,
+			statuses: []int{
+				http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway,
+				http.StatusBadGateway, http.StatusOK,
+			},
+
+			wantStatus:   http.StatusBadGateway,
+			wantAttempts: 3,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts atomic.Int32
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := attempts.Add(1)
+				if body, _ := io.ReadAll(r.Body); r.Method != http.MethodGet && string(body) != "{}" {
+					t.Errorf("unexpected body at attempt %d: '%s'", n, body)
+				}
+				w.WriteHeader(tt.statuses[n-1])
+			}))
+			defer ts.Close()
+
+			client := &http.Client{Transport: &retryTransport{
+				base:       &timeoutTransport{base: http.DefaultTransport, timeout: time.Second},
+				maxRetries: 2,
+				waitMin:    time.Millisecond,
+				waitMax:    10 * time.Millisecond,
+			}}
+
+			var body io.Reader
+			if tt.method != http.MethodGet {
+				body = strings.NewReader("{}")
+			}
+			req, err := http.NewRequest(tt.method, ts.URL, body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("expected status %d, but got %d", tt.wantStatus, resp.StatusCode)
+			}
+			if got := attempts.Load(); got != tt.wantAttempts {
+				t.Errorf("expected %d attempts, but got %d", tt.wantAttempts, got)
+			}
+		})
+	}
+}
+
+func Test_retryTransport_nonRewindableBody(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := attempts.Add(1)
+		if body, _ := io.ReadAll(r.Body); string(body) != "{}" {
+			t.Errorf("unexpected body at attempt %d: '%s'", n, body)
+		}
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: &retryTransport{
+		base:       http.DefaultTransport,
+		maxRetries: 2,
+		waitMin:    time.Millisecond,
+		waitMax:    10 * time.Millisecond,
+	}}
+
+	// http.NewRequest cannot set GetBody for readers other than bytes and strings ones.
+	req, err := http.NewRequest(http.MethodPost, ts.URL, io.MultiReader(strings.NewReader("{}")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.GetBody != nil {
+		t.Fatal("expected the body not to be rewindable")
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected status %d, but got %d", http.StatusTooManyRequests, resp.StatusCode)
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("expected 1 attempt, but got %d", got)
+	}
+}
+
+func Test_retryTransport_backoff(t *testing.T) {
+	t.Parallel()
+
+	tr := &retryTransport{
+		waitMin: 1 * time.Second,
+		waitMax: 10 * time.Second,
+	}
+
+	cases := map[string]struct {
+		attempt    int
+		retryAfter string
+		want       time.Duration
+	}{
+		"first":                  {attempt: 0, want: 1 * time.Second},
+		"second":                 {attempt: 1, want: 2 * time.Second},
+		"third":                  {attempt: 2, want: 4 * time.Second},
+		"capped":                 {attempt: 5, want: 10 * time.Second},
+		"retry-after":            {attempt: 0, retryAfter: "3", want: 3 * time.Second},
+		"retry-after is capped":  {attempt: 0, retryAfter: "120", want: 10 * time.Second},
+		"retry-after is floored": {attempt: 3, retryAfter: "0", want: 1 * time.Second},
+		"invalid retry-after":    {attempt: 1, retryAfter: "soon", want: 2 * time.Second},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &http.Response{Header: http.Header{}}
+			if tt.retryAfter != "" {
+				resp.Header.Set("Retry-After", tt.retryAfter)
+			}
+			if got := tr.backoff(tt.attempt, resp); got != tt.want {
+				t.Errorf("expected %s, but got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_ClientConfig_retry(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		in      ClientConfigModel
+		wantErr bool
+	}{
+		"default": {
+			in: ClientConfigModel{},
+		},
+		"custom": {
+			in: ClientConfigModel{
+				MaxRetries:   types.Int64Value(10),
+				RetryWaitMin: types.Int64Value(2),
+				RetryWaitMax: types.Int64Value(60),
+			},
+		},
+		"min > max": {
+			in: ClientConfigModel{
+				RetryWaitMin: types.Int64Value(60),
+				RetryWaitMax: types.Int64Value(2),
+			},
+			wantErr: true,
+		},
+		"negative retries": {
+			in: ClientConfigModel{
+				MaxRetries: types.Int64Value(-1),
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := tt.in.newRetryTransport(http.DefaultTransport); (err != nil) != tt.wantErr {
+				t.Errorf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
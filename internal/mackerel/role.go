@@ -44,25 +44,35 @@ func RoleNameValidator() validator.String {
 	)
 }
 
+var roleFullnameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-_]+:[a-zA-Z0-9][a-zA-Z0-9-_]+$`)
+
+// Validates a role fullname in the form of `<service>:<role>`.
+func RoleFullnameValidator() validator.String {
+	return stringvalidator.RegexMatches(
+		roleFullnameRegex,
+		"it must be in the form of `<service>:<role>`",
+	)
+}
+
 func ReadRole(ctx context.Context, client *Client, serviceName, roleName string) (RoleModel, error) {
 	return readRoleInner(ctx, client, serviceName, roleName)
 }
 
 type roleFinder interface {
-	FindRoles(string) ([]*mackerel.Role, error)
+	FindRolesContext(context.Context, string) ([]*mackerel.Role, error)
 }
 
-func readRoleInner(_ context.Context, client roleFinder, serviceName, roleName string) (RoleModel, error) {
-	roles, err := client.FindRoles(serviceName)
+func readRoleInner(ctx context.Context, client roleFinder, serviceName, roleName string) (RoleModel, error) {
+	roles, err := client.FindRolesContext(ctx, serviceName)
 	if err != nil {
-		return RoleModel{}, err
+		return RoleModel{}, wrapNotFound(err)
 	}
 
 	roleIdx := slices.IndexFunc(roles, func(r *mackerel.Role) bool {
 		return r.Name == roleName
 	})
 	if roleIdx < 0 {
-		return RoleModel{}, fmt.Errorf("the name '%s' does not match any role in mackerel.io", roleName)
+		return RoleModel{}, newNotFoundError("the name '%s' does not match any role in mackerel.io", roleName)
 	}
 
 	role := roles[roleIdx]
@@ -74,9 +84,9 @@ func readRoleInner(_ context.Context, client roleFinder, serviceName, roleName s
 	}, nil
 }
 
-func (m *RoleModel) Create(_ context.Context, client *Client) error {
+func (m *RoleModel) Create(ctx context.Context, client *Client) error {
 	serviceName := m.ServiceName.ValueString()
-	if _, err := client.CreateRole(serviceName, &mackerel.CreateRoleParam{
+	if _, err := client.CreateRoleContext(ctx, serviceName, &mackerel.CreateRoleParam{
 		Name: m.RoleName.ValueString(),
 		Memo: m.Memo.ValueString(),
 	}); err != nil {
@@ -118,8 +128,9 @@ func (m *RoleModel) readInner(ctx context.Context, client roleFinder) error {
 	return nil
 }
 
-func (m *RoleModel) Delete(_ context.Context, client *Client) error {
-	if _, err := client.DeleteRole(
+func (m *RoleModel) Delete(ctx context.Context, client *Client) error {
+	if _, err := client.DeleteRoleContext(
+		ctx,
 		m.ServiceName.ValueString(),
 		m.RoleName.ValueString(),
 	); err != nil {
@@ -33,17 +33,17 @@ func parseRoleMetadataID(id string) (serviceName, roleName, namespace string, er
 }
 
 func ReadRoleMetadata(ctx context.Context, client *Client, serviceName, roleName, namespace string) (RoleMetadataModel, error) {
-	return readRoleMetadata(client, serviceName, roleName, namespace)
+	return readRoleMetadata(ctx, client, serviceName, roleName, namespace)
 }
 
 type roleMetadataReader interface {
-	GetRoleMetaData(serviceName, roleName, namespace string) (*mackerel.RoleMetaDataResp, error)
+	GetRoleMetaDataContext(ctx context.Context, serviceName, roleName, namespace string) (*mackerel.RoleMetaDataResp, error)
 }
 
-func readRoleMetadata(client roleMetadataReader, serviceName, roleName, namespace string) (RoleMetadataModel, error) {
-	metadataResp, err := client.GetRoleMetaData(serviceName, roleName, namespace)
+func readRoleMetadata(ctx context.Context, client roleMetadataReader, serviceName, roleName, namespace string) (RoleMetadataModel, error) {
+	metadataResp, err := client.GetRoleMetaDataContext(ctx, serviceName, roleName, namespace)
 	if err != nil {
-		return RoleMetadataModel{}, err
+		return RoleMetadataModel{}, wrapNotFound(err)
 	}
 
 	metadataJSON, err := json.Marshal(metadataResp.RoleMetaData)
@@ -75,11 +75,11 @@ func ImportRoleMetadata(id string) (RoleMetadataModel, error) {
 }
 
 func (m *RoleMetadataModel) Create(ctx context.Context, client *Client) error {
-	return m.create(client)
+	return m.create(ctx, client)
 }
 
-func (m *RoleMetadataModel) create(client roleMetadataUpdator) error {
-	if err := m.update(client); err != nil {
+func (m *RoleMetadataModel) create(ctx context.Context, client roleMetadataUpdator) error {
+	if err := m.update(ctx, client); err != nil {
 		return err
 	}
 
@@ -92,6 +92,7 @@ func (m *RoleMetadataModel) create(client roleMetadataUpdator) error {
 
 func (m *RoleMetadataModel) Read(ctx context.Context, client *Client) error {
 	data, err := readRoleMetadata(
+		ctx,
 		client,
 		m.ServiceName.ValueString(),
 		m.RoleName.ValueString(),
@@ -107,19 +108,20 @@ func (m *RoleMetadataModel) Read(ctx context.Context, client *Client) error {
 }
 
 func (m RoleMetadataModel) Update(ctx context.Context, client *Client) error {
-	return m.update(client)
+	return m.update(ctx, client)
 }
 
 type roleMetadataUpdator interface {
-	PutRoleMetaData(serviceName, roleName, namespace string, metadata mackerel.RoleMetaData) error
+	PutRoleMetaDataContext(ctx context.Context, serviceName, roleName, namespace string, metadata mackerel.RoleMetaData) error
 }
 
-func (m *RoleMetadataModel) update(client roleMetadataUpdator) error {
+func (m *RoleMetadataModel) update(ctx context.Context, client roleMetadataUpdator) error {
 	var metadata mackerel.RoleMetaData
 	if err := json.Unmarshal([]byte(m.MetadataJSON.ValueString()), &metadata); err != nil {
 		return fmt.Errorf("failed to unmarshal metadata: %w", err)
 	}
-	if err := client.PutRoleMetaData(
+	if err := client.PutRoleMetaDataContext(
+		ctx,
 		m.ServiceName.ValueString(),
 		m.RoleName.ValueString(),
 		m.Namespace.ValueString(),
@@ -130,8 +132,9 @@ func (m *RoleMetadataModel) update(client roleMetadataUpdator) error {
 	return nil
 }
 
-func (m RoleMetadataModel) Delete(_ context.Context, client *Client) error {
-	if err := client.DeleteRoleMetaData(
+func (m RoleMetadataModel) Delete(ctx context.Context, client *Client) error {
+	if err := client.DeleteRoleMetaDataContext(
+		ctx,
 		m.ServiceName.ValueString(),
 		m.RoleName.ValueString(),
 		m.Namespace.ValueString(),
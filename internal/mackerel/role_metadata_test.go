@@ -1,6 +1,7 @@
 package mackerel
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -50,7 +51,7 @@ func Test_ReadRoleMetadata(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			data, err := readRoleMetadata(tt.inClient, tt.inServiceName, tt.inRoleName, tt.inNamespace)
+			data, err := readRoleMetadata(context.Background(), tt.inClient, tt.inServiceName, tt.inRoleName, tt.inNamespace)
 			if err != nil {
 				t.Errorf("unexpected error: %+v", err)
 				return
@@ -156,7 +157,7 @@ func Test_RoleMetadata_Create(t *testing.T) {
 			t.Parallel()
 
 			data := tt.in
-			if err := data.create(tt.inClient); err != nil {
+			if err := data.create(context.Background(), tt.inClient); err != nil {
 				t.Errorf("unexpected error: %+v", err)
 				return
 			}
@@ -170,12 +171,12 @@ func Test_RoleMetadata_Create(t *testing.T) {
 
 type roleMetadataReaderFunc func(serviceName, roleName, namespace string) (*mackerel.RoleMetaDataResp, error)
 
-func (f roleMetadataReaderFunc) GetRoleMetaData(serviceName, roleName, namespace string) (*mackerel.RoleMetaDataResp, error) {
+func (f roleMetadataReaderFunc) GetRoleMetaDataContext(_ context.Context, serviceName, roleName, namespace string) (*mackerel.RoleMetaDataResp, error) {
 	return f(serviceName, roleName, namespace)
 }
 
 type roleMetadataUpdatorFunc func(serviceName, roleName, namespace string, metadata mackerel.RoleMetaData) error
 
-func (f roleMetadataUpdatorFunc) PutRoleMetaData(serviceName, roleName, namespace string, metadata mackerel.RoleMetaData) error {
+func (f roleMetadataUpdatorFunc) PutRoleMetaDataContext(_ context.Context, serviceName, roleName, namespace string, metadata mackerel.RoleMetaData) error {
 	return f(serviceName, roleName, namespace, metadata)
 }
@@ -153,6 +153,6 @@ func Test_RoleModel_Read(t *testing.T) {
 
 type roleFinderFunc func(string) ([]*mackerel.Role, error)
 
-func (f roleFinderFunc) FindRoles(serviceName string) ([]*mackerel.Role, error) {
+func (f roleFinderFunc) FindRolesContext(_ context.Context, serviceName string) ([]*mackerel.Role, error) {
 	return f(serviceName)
 }
@@ -2,7 +2,6 @@ package mackerel
 
 import (
 	"context"
-	"fmt"
 	"regexp"
 	"slices"
 
@@ -32,7 +31,7 @@ type ServiceModelV0 struct {
 	Roles types.Set    `tfsdk:"roles"`
 }
 
-func ImportService(_ context.Context, id string) (ServiceModel, error) {
+func ImportService(ctx context.Context, id string) (ServiceModel, error) {
 	return ServiceModelV0{
 		ID:    types.StringValue(id),
 		Name:  id,
@@ -42,17 +41,16 @@ func ImportService(_ context.Context, id string) (ServiceModel, error) {
 }
 
 // Reads a service by the name.
-// Currently, this function is NOT cancelable.
-func ReadService(_ context.Context, client *Client, name string) (ServiceModel, error) {
-	return readServiceInner(client, name)
+func ReadService(ctx context.Context, client *Client, name string) (ServiceModel, error) {
+	return readServiceInner(ctx, client, name)
 }
 
 type serviceFinder interface {
-	FindServices() ([]*mackerel.Service, error)
+	FindServicesContext(context.Context) ([]*mackerel.Service, error)
 }
 
-func readServiceInner(client serviceFinder, name string) (ServiceModel, error) {
-	services, err := client.FindServices()
+func readServiceInner(ctx context.Context, client serviceFinder, name string) (ServiceModel, error) {
+	services, err := client.FindServicesContext(ctx)
 	if err != nil {
 		return ServiceModel{}, err
 	}
@@ -61,7 +59,7 @@ func readServiceInner(client serviceFinder, name string) (ServiceModel, error) {
 		return s.Name == name
 	})
 	if serviceIdx == -1 {
-		return ServiceModel{}, fmt.Errorf("the name '%s' does not match any service in mackerel.io", name)
+		return ServiceModel{}, newNotFoundError("the name '%s' does not match any service in mackerel.io", name)
 	}
 
 	service := services[serviceIdx]
@@ -79,14 +77,13 @@ func readServiceInner(client serviceFinder, name string) (ServiceModel, error) {
 }
 
 // Creates a service.
-// This functions is NOT cancelable.
-func (m *ServiceModel) Create(_ context.Context, client *Client) error {
+func (m *ServiceModel) Create(ctx context.Context, client *Client) error {
 	param := mackerel.CreateServiceParam{
 		Name: m.Name,
 		Memo: m.Memo.ValueString(),
 	}
 
-	service, err := client.CreateService(&param)
+	service, err := client.CreateServiceContext(ctx, &param)
 	if err != nil {
 		return err
 	}
@@ -96,15 +93,14 @@ func (m *ServiceModel) Create(_ context.Context, client *Client) error {
 }
 
 // Reads a service and updates state.
-// This function is NOT cancelable.
-func (m *ServiceModel) Read(_ context.Context, client *Client) error {
+func (m *ServiceModel) Read(ctx context.Context, client *Client) error {
 	var name string
 	if !m.ID.IsUnknown() {
 		name = m.ID.ValueString()
 	} else {
 		name = m.Name
 	}
-	remoteData, err := readServiceInner(client, name)
+	remoteData, err := readServiceInner(ctx, client, name)
 	if err != nil {
 		return err
 	}
@@ -113,9 +109,8 @@ func (m *ServiceModel) Read(_ context.Context, client *Client) error {
 }
 
 // Deletes a service.
-// This function is NOT cancelable.
-func (m ServiceModel) Delete(_ context.Context, client *Client) error {
-	if _, err := client.DeleteService(m.ID.ValueString()); err != nil {
+func (m ServiceModel) Delete(ctx context.Context, client *Client) error {
+	if _, err := client.DeleteServiceContext(ctx, m.ID.ValueString()); err != nil {
 		return err
 	}
 	return nil
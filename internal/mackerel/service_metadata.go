@@ -37,18 +37,18 @@ func ReadServiceMetadata(ctx context.Context, client *Client, data ServiceMetada
 }
 
 type serviceMetadataGetter interface {
-	GetServiceMetaData(string, string) (*mackerel.ServiceMetaDataResp, error)
+	GetServiceMetaDataContext(context.Context, string, string) (*mackerel.ServiceMetaDataResp, error)
 }
 
-func readServiceMetadataInner(_ context.Context, client serviceMetadataGetter, data ServiceMetadataModel) (ServiceMetadataModel, error) {
+func readServiceMetadataInner(ctx context.Context, client serviceMetadataGetter, data ServiceMetadataModel) (ServiceMetadataModel, error) {
 	serviceName, namespace, err := data.getID()
 	if err != nil {
 		return ServiceMetadataModel{}, err
 	}
 
-	metadataResp, err := client.GetServiceMetaData(serviceName, namespace)
+	metadataResp, err := client.GetServiceMetaDataContext(ctx, serviceName, namespace)
 	if err != nil {
-		return ServiceMetadataModel{}, err
+		return ServiceMetadataModel{}, wrapNotFound(err)
 	}
 
 	data.ID = types.StringValue(serviceMetadataID(serviceName, namespace))
@@ -106,7 +106,7 @@ func (m *ServiceMetadataModel) Validate(base path.Path) (diags diag.Diagnostics)
 	return
 }
 
-func (m *ServiceMetadataModel) CreateOrUpdateMetadata(_ context.Context, client *Client) error {
+func (m *ServiceMetadataModel) CreateOrUpdateMetadata(ctx context.Context, client *Client) error {
 	serviceName, namespace, err := m.getID()
 	if err != nil {
 		return err
@@ -120,7 +120,7 @@ func (m *ServiceMetadataModel) CreateOrUpdateMetadata(_ context.Context, client
 		return fmt.Errorf("failed to unmarshal metadata: %w", err)
 	}
 
-	if err := client.PutServiceMetaData(serviceName, namespace, metadata); err != nil {
+	if err := client.PutServiceMetaDataContext(ctx, serviceName, namespace, metadata); err != nil {
 		return err
 	}
 
@@ -128,13 +128,13 @@ func (m *ServiceMetadataModel) CreateOrUpdateMetadata(_ context.Context, client
 	return nil
 }
 
-func (m *ServiceMetadataModel) Delete(_ context.Context, client *Client) error {
+func (m *ServiceMetadataModel) Delete(ctx context.Context, client *Client) error {
 	serviceName, namespace, err := m.getID()
 	if err != nil {
 		return err
 	}
 
-	if err := client.DeleteServiceMetaData(serviceName, namespace); err != nil {
+	if err := client.DeleteServiceMetaDataContext(ctx, serviceName, namespace); err != nil {
 		return err
 	}
 
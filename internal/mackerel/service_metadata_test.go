@@ -97,7 +97,7 @@ func Test_ReadServiceMetadata(t *testing.T) {
 
 type serviceMetadataGetterFunc func(string, string) (*mackerel.ServiceMetaDataResp, error)
 
-func (f serviceMetadataGetterFunc) GetServiceMetaData(serviceName, namespace string) (*mackerel.ServiceMetaDataResp, error) {
+func (f serviceMetadataGetterFunc) GetServiceMetaDataContext(_ context.Context, serviceName, namespace string) (*mackerel.ServiceMetaDataResp, error) {
 	return f(serviceName, namespace)
 }
 
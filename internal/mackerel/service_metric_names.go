@@ -19,17 +19,17 @@ func ReadServiceMetricNames(ctx context.Context, client *Client, state ServiceMe
 }
 
 type serviceMetricNamesReader interface {
-	ListServiceMetricNames(string) ([]string, error)
+	ListServiceMetricNamesContext(context.Context, string) ([]string, error)
 }
 
-func readServiceMetricNamesInner(_ context.Context, client serviceMetricNamesReader, state ServiceMetricNamesModel) (ServiceMetricNamesModel, error) {
+func readServiceMetricNamesInner(ctx context.Context, client serviceMetricNamesReader, state ServiceMetricNamesModel) (ServiceMetricNamesModel, error) {
 	name := state.Name.ValueString()
 	prefix := state.Prefix.ValueString()
 
 	data := state
 	data.ID = types.StringValue(name + ":" + prefix)
 
-	names, err := client.ListServiceMetricNames(name)
+	names, err := client.ListServiceMetricNamesContext(ctx, name)
 	if err != nil {
 		return data, err
 	}
@@ -11,7 +11,7 @@ import (
 
 type serviceMetricNamesReaderFunc func(string) ([]string, error)
 
-func (f serviceMetricNamesReaderFunc) ListServiceMetricNames(name string) ([]string, error) {
+func (f serviceMetricNamesReaderFunc) ListServiceMetricNamesContext(_ context.Context, name string) ([]string, error) {
 	return f(name)
 }
 
@@ -62,7 +62,7 @@ func Test_ServiceNameValidator(t *testing.T) {
 
 type serviceFinderFunc func() ([]*mackerel.Service, error)
 
-func (f serviceFinderFunc) FindServices() ([]*mackerel.Service, error) {
+func (f serviceFinderFunc) FindServicesContext(_ context.Context) ([]*mackerel.Service, error) {
 	return f()
 }
 
@@ -122,7 +122,7 @@ func Test_ReadService(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			s, err := readServiceInner(tt.inClient, tt.inName)
+			s, err := readServiceInner(context.Background(), tt.inClient, tt.inName)
 			if err != nil {
 				if !tt.wantFail {
 					t.Errorf("unexpected error: %+v", err)
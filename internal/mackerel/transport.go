@@ -0,0 +1,113 @@
+package mackerel
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Builds the transport which actually sends requests, from proxy and TLS settings.
+func (m *ClientConfigModel) newBaseTransport() (*http.Transport, error) {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+
+	if proxyURL := m.ProxyURL.ValueString(); proxyURL != "" {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy_url: %w", err)
+		}
+		t.Proxy = http.ProxyURL(u)
+	}
+
+	pem, err := m.caCertPEM()
+	if err != nil {
+		return nil, err
+	}
+	if pem != nil || m.InsecureSkipVerify.ValueBool() {
+		t.TLSClientConfig = &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: m.InsecureSkipVerify.ValueBool(), //nolint:gosec // explicitly requested by the user
+		}
+	}
+	if pem != nil {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.New("no valid certificates are found in ca_cert_file or ca_cert_pem")
+		}
+		t.TLSClientConfig.RootCAs = pool
+	}
+
+	return t, nil
+}
+
+// Returns nil if no CA certificate is configured.
+func (m *ClientConfigModel) caCertPEM() ([]byte, error) {
+	if pem := m.CACertPEM.ValueString(); pem != "" {
+		return []byte(pem), nil
+	}
+	if path := m.CACertFile.ValueString(); path != "" {
+		pem, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ca_cert_file: %w", err)
+		}
+		return pem, nil
+	}
+	return nil, nil
+}
+
+// Returns nil if no extra header is configured.
+func (m *ClientConfigModel) extraHeaders() (http.Header, error) {
+	if m.ExtraHeaders.IsNull() || m.ExtraHeaders.IsUnknown() {
+		return nil, nil
+	}
+
+	header := make(http.Header, len(m.ExtraHeaders.Elements()))
+	for name, v := range m.ExtraHeaders.Elements() {
+		switch http.CanonicalHeaderKey(name) {
+		case "X-Api-Key", "User-Agent", "Content-Type":
+			return nil, fmt.Errorf("%s cannot be set by extra_headers", name)
+		}
+		s, ok := v.(types.String)
+		if !ok {
+			return nil, fmt.Errorf("invalid value of extra_headers.%s", name)
+		}
+		header.Set(name, s.ValueString())
+	}
+	return header, nil
+}
+
+// Describes the provider and Terraform in User-Agent,
+// e.g. "terraform-provider-mackerel/0.7.0 Terraform/1.9.0 mackerel-client-go".
+func UserAgent(providerVersion, terraformVersion string) string {
+	ua := []string{"terraform-provider-mackerel/" + providerVersion}
+	if terraformVersion != "" {
+		ua = append(ua, "Terraform/"+terraformVersion)
+	}
+	ua = append(ua, "mackerel-client-go")
+	return strings.Join(ua, " ")
+}
+
+// headerTransport adds the headers to every request.
+type headerTransport struct {
+	base   http.RoundTripper
+	header http.Header
+}
+
+var _ http.RoundTripper = (*headerTransport)(nil)
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for name, values := range t.header {
+		req.Header[name] = values
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,148 @@
+package mackerel
+
+import (
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Test_ClientConfig_caCert(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"name":"org"}`)
+	}))
+	t.Cleanup(ts.Close)
+
+	certPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}))
+	certFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(certFile, []byte(certPEM), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		in      ClientConfigModel
+		wantErr bool
+	}{
+		"untrusted": {
+			wantErr: true,
+		},
+		"ca_cert_pem": {
+			in: ClientConfigModel{CACertPEM: types.StringValue(certPEM)},
+		},
+		"ca_cert_file": {
+			in: ClientConfigModel{CACertFile: types.StringValue(certFile)},
+		},
+		"insecure_skip_verify": {
+			in: ClientConfigModel{InsecureSkipVerify: types.BoolValue(true)},
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := tt.in
+			config.APIKey = types.StringValue("api-key")
+			config.APIBase = types.StringValue(ts.URL)
+			config.MaxRetries = types.Int64Value(0)
+			client, err := config.NewClient()
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			_, err = client.GetOrgContext(t.Context())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+func Test_ClientConfig_invalidCACert(t *testing.T) {
+	t.Parallel()
+
+	config := ClientConfigModel{CACertPEM: types.StringValue("not a certificate")}
+	if _, err := config.newBaseTransport(); err == nil {
+		t.Error("expected error, but got no error")
+	}
+}
+
+func Test_ClientConfig_proxy(t *testing.T) {
+	t.Parallel()
+
+	config := ClientConfigModel{ProxyURL: types.StringValue("http://proxy.example.test:8080")}
+	transport, err := config.newBaseTransport()
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.mackerelio.com/api/v0/org", nil)
+	u, err := transport.Proxy(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if got := u.String(); got != "http://proxy.example.test:8080" {
+		t.Errorf("unexpected proxy: %s", got)
+	}
+}
+
+func Test_ClientConfig_headers(t *testing.T) {
+	t.Parallel()
+
+	var got http.Header
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"name":"org"}`)
+	}))
+	t.Cleanup(ts.Close)
+
+	config := ClientConfigModel{
+		APIKey:  types.StringValue("api-key"),
+		APIBase: types.StringValue(ts.URL),
+		ExtraHeaders: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"x-team": types.StringValue("sre"),
+		}),
+		UserAgent: UserAgent("1.2.3", "1.9.0"),
+	}
+	client, err := config.NewClient()
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if _, err := client.GetOrgContext(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if diff := cmp.Diff("sre", got.Get("X-Team")); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff("terraform-provider-mackerel/1.2.3 Terraform/1.9.0 mackerel-client-go", got.Get("User-Agent")); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff("api-key", got.Get("X-Api-Key")); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func Test_ClientConfig_reservedHeaders(t *testing.T) {
+	t.Parallel()
+
+	config := ClientConfigModel{
+		ExtraHeaders: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"x-api-key": types.StringValue("another-key"),
+		}),
+	}
+	if _, err := config.extraHeaders(); err == nil {
+		t.Error("expected error, but got no error")
+	}
+}
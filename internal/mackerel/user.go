@@ -0,0 +1,76 @@
+package mackerel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+type UserModel struct {
+	ID           types.String `tfsdk:"id"`
+	ScreenName   types.String `tfsdk:"screen_name"`
+	Email        types.String `tfsdk:"email"`
+	Authority    types.String `tfsdk:"authority"`
+	IsMFAEnabled types.Bool   `tfsdk:"is_mfa_enabled"`
+}
+
+func UserAuthorityValidator() validator.String {
+	return stringvalidator.OneOf("owner", "manager", "collaborator", "viewer")
+}
+
+// Finds a user in the organization by one of `email` and `screen_name` of the config.
+// Emails are compared case-insensitively.
+func FindUser(ctx context.Context, client *Client, config UserModel) (UserModel, error) {
+	return findUserInner(ctx, client, config)
+}
+
+type usersFinder interface {
+	FindUsersContext(context.Context) ([]*mackerel.User, error)
+}
+
+func findUserInner(ctx context.Context, client usersFinder, config UserModel) (UserModel, error) {
+	users, err := client.FindUsersContext(ctx)
+	if err != nil {
+		return UserModel{}, err
+	}
+
+	var key, value string
+	var match func(*mackerel.User) bool
+	if !config.Email.IsNull() {
+		key, value = "email", config.Email.ValueString()
+		match = func(u *mackerel.User) bool { return strings.EqualFold(u.Email, value) }
+	} else {
+		key, value = "screen name", config.ScreenName.ValueString()
+		match = func(u *mackerel.User) bool { return u.ScreenName == value }
+	}
+
+	var found []*mackerel.User
+	for _, u := range users {
+		if match(u) {
+			found = append(found, u)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return UserModel{}, newNotFoundError("the %s '%s' does not match any user in the organization", key, value)
+	case 1:
+		return newUser(*found[0]), nil
+	default:
+		return UserModel{}, fmt.Errorf("the %s '%s' matches %d users, use email instead", key, value, len(found))
+	}
+}
+
+func newUser(u mackerel.User) UserModel {
+	return UserModel{
+		ID:           types.StringValue(u.ID),
+		ScreenName:   types.StringValue(u.ScreenName),
+		Email:        types.StringValue(u.Email),
+		Authority:    types.StringValue(u.Authority),
+		IsMFAEnabled: types.BoolValue(u.IsMFAEnabled),
+	}
+}
@@ -0,0 +1,86 @@
+package mackerel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+var testUsers = []*mackerel.User{
+	{ID: "user0", ScreenName: "alice", Email: "alice@example.com", Authority: "owner", IsMFAEnabled: true},
+	{ID: "user1", ScreenName: "bob", Email: "bob@example.com", Authority: "collaborator"},
+	{ID: "user2", ScreenName: "bob", Email: "bob@example.net", Authority: "viewer"},
+}
+
+func Test_User_findUserInner(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		in           UserModel
+		wants        UserModel
+		wantErr      bool
+		wantNotFound bool
+	}{
+		"email": {
+			in: UserModel{Email: types.StringValue("Alice@Example.com")},
+			wants: UserModel{
+				ID:           types.StringValue("user0"),
+				ScreenName:   types.StringValue("alice"),
+				Email:        types.StringValue("alice@example.com"),
+				Authority:    types.StringValue("owner"),
+				IsMFAEnabled: types.BoolValue(true),
+			},
+		},
+		"screen name": {
+			in: UserModel{Email: types.StringNull(), ScreenName: types.StringValue("alice")},
+			wants: UserModel{
+				ID:           types.StringValue("user0"),
+				ScreenName:   types.StringValue("alice"),
+				Email:        types.StringValue("alice@example.com"),
+				Authority:    types.StringValue("owner"),
+				IsMFAEnabled: types.BoolValue(true),
+			},
+		},
+		"ambiguous screen name": {
+			in:      UserModel{Email: types.StringNull(), ScreenName: types.StringValue("bob")},
+			wantErr: true,
+		},
+		"not found": {
+			in:           UserModel{Email: types.StringValue("carol@example.com")},
+			wantErr:      true,
+			wantNotFound: true,
+		},
+	}
+
+	client := usersFinderFunc(func() ([]*mackerel.User, error) {
+		return testUsers, nil
+	})
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := findUserInner(context.Background(), client, tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if IsNotFound(err) != tt.wantNotFound {
+				t.Errorf("unexpected error: %+v", err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.wants, got); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+type usersFinderFunc func() ([]*mackerel.User, error)
+
+func (f usersFinderFunc) FindUsersContext(context.Context) ([]*mackerel.User, error) {
+	return f()
+}
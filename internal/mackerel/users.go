@@ -0,0 +1,52 @@
+package mackerel
+
+import (
+	"context"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type UsersModel struct {
+	ID          types.String `tfsdk:"id"`
+	Authorities []string     `tfsdk:"authorities"`
+	IDs         []string     `tfsdk:"ids"`
+	Users       []UserModel  `tfsdk:"users"`
+}
+
+// Reads users in the organization which have one of the authorities in the config.
+func ReadUsers(ctx context.Context, client *Client, config UsersModel) (UsersModel, error) {
+	return readUsersInner(ctx, client, config)
+}
+
+func readUsersInner(ctx context.Context, client usersFinder, config UsersModel) (UsersModel, error) {
+	data := config
+	data.ID = types.StringValue(usersID(config.Authorities))
+
+	users, err := client.FindUsersContext(ctx)
+	if err != nil {
+		return data, err
+	}
+
+	data.IDs = make([]string, 0, len(users))
+	data.Users = make([]UserModel, 0, len(users))
+	for _, u := range users {
+		if len(config.Authorities) > 0 && !slices.Contains(config.Authorities, u.Authority) {
+			continue
+		}
+		data.IDs = append(data.IDs, u.ID)
+		data.Users = append(data.Users, newUser(*u))
+	}
+	return data, nil
+}
+
+// Identifies the data source by the filters, e.g. "authority=manager&authority=owner".
+func usersID(authorities []string) string {
+	if len(authorities) == 0 {
+		return "all"
+	}
+	authorities = slices.Clone(authorities)
+	slices.Sort(authorities)
+	return url.Values{"authority": authorities}.Encode()
+}
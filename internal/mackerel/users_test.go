@@ -0,0 +1,52 @@
+package mackerel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+func Test_Users_readUsersInner(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		in      UsersModel
+		wantID  string
+		wantIDs []string
+	}{
+		"no filter": {
+			wantID:  "all",
+			wantIDs: []string{"user0", "user1", "user2"},
+		},
+		"authorities": {
+			in:      UsersModel{Authorities: []string{"viewer", "owner"}},
+			wantID:  "authority=owner&authority=viewer",
+			wantIDs: []string{"user0", "user2"},
+		},
+	}
+
+	client := usersFinderFunc(func() ([]*mackerel.User, error) {
+		return testUsers, nil
+	})
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := readUsersInner(context.Background(), client, tt.in)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if got.ID.ValueString() != tt.wantID {
+				t.Errorf("expected the ID %q, but got %q", tt.wantID, got.ID.ValueString())
+			}
+			if diff := cmp.Diff(tt.wantIDs, got.IDs); diff != "" {
+				t.Error(diff)
+			}
+			if len(got.Users) != len(tt.wantIDs) {
+				t.Errorf("unexpected users: %+v", got.Users)
+			}
+		})
+	}
+}
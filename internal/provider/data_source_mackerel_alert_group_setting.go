@@ -3,15 +3,18 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/mackerel"
 )
 
 var (
-	_ datasource.DataSource              = (*mackerelAlertGroupSettingDataSource)(nil)
-	_ datasource.DataSourceWithConfigure = (*mackerelAlertGroupSettingDataSource)(nil)
+	_ datasource.DataSource                     = (*mackerelAlertGroupSettingDataSource)(nil)
+	_ datasource.DataSourceWithConfigure        = (*mackerelAlertGroupSettingDataSource)(nil)
+	_ datasource.DataSourceWithConfigValidators = (*mackerelAlertGroupSettingDataSource)(nil)
 )
 
 func NewMackerelAlertGroupSettingDataSource() datasource.DataSource {
@@ -22,6 +25,11 @@ type mackerelAlertGroupSettingDataSource struct {
 	Client *mackerel.Client
 }
 
+type mackerelAlertGroupSettingDataSourceModel struct {
+	mackerel.AlertGroupSettingModel
+	AllLabels map[string]string `tfsdk:"all_labels"`
+}
+
 func (d *mackerelAlertGroupSettingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_alert_group_setting"
 }
@@ -30,6 +38,15 @@ func (d *mackerelAlertGroupSettingDataSource) Schema(_ context.Context, _ dataso
 	resp.Schema = schemaAlertGroupSettingDataSource
 }
 
+func (d *mackerelAlertGroupSettingDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("labels"),
+		),
+	}
+}
+
 func (d *mackerelAlertGroupSettingDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	client, diags := retrieveClient(ctx, req.ProviderData)
 	resp.Diagnostics.Append(diags...)
@@ -40,13 +57,19 @@ func (d *mackerelAlertGroupSettingDataSource) Configure(ctx context.Context, req
 }
 
 func (d *mackerelAlertGroupSettingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var config mackerel.AlertGroupSettingModel
+	var config mackerelAlertGroupSettingDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data, err := mackerel.ReadAlertGroupSetting(ctx, d.Client, config.ID.ValueString())
+	var model mackerel.AlertGroupSettingModel
+	var err error
+	if config.ID.IsNull() {
+		model, err = mackerel.FindAlertGroupSettingByLabels(ctx, d.Client, config.Labels)
+	} else {
+		model, err = mackerel.ReadAlertGroupSetting(ctx, d.Client, config.ID.ValueString())
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to read an alert group setting.",
@@ -55,6 +78,13 @@ func (d *mackerelAlertGroupSettingDataSource) Read(ctx context.Context, req data
 		return
 	}
 
+	// Keep the labels as configured since they are the filter, and expose all of them separately.
+	data := mackerelAlertGroupSettingDataSourceModel{
+		AlertGroupSettingModel: model,
+		AllLabels:              model.Labels,
+	}
+	data.Labels = config.Labels
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -63,7 +93,8 @@ var schemaAlertGroupSettingDataSource = schema.Schema{
 	Attributes: map[string]schema.Attribute{
 		"id": schema.StringAttribute{
 			Description: schemaAlertGroupSettingIDDesc,
-			Required:    true,
+			Optional:    true,
+			Computed:    true,
 		},
 		"name": schema.StringAttribute{
 			Description: schemaAlertGroupSettingNameDesc,
@@ -73,6 +104,16 @@ var schemaAlertGroupSettingDataSource = schema.Schema{
 			Description: schemaAlertGroupSettingMemoDesc,
 			Computed:    true,
 		},
+		"labels": schema.MapAttribute{
+			Description: "A map of labels to find the alert group setting by. The only alert group setting which has all of these labels is read.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"all_labels": schema.MapAttribute{
+			Description: schemaAlertGroupSettingLabelsDesc,
+			ElementType: types.StringType,
+			Computed:    true,
+		},
 		"service_scopes": schema.SetAttribute{
 			ElementType: types.StringType,
 			Description: schemaAlertGroupSettingServiceScopesDesc,
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/mackerel"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/typeutil"
+)
+
+var (
+	_ datasource.DataSource                   = (*mackerelAlertsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure      = (*mackerelAlertsDataSource)(nil)
+	_ datasource.DataSourceWithValidateConfig = (*mackerelAlertsDataSource)(nil)
+)
+
+func NewMackerelAlertsDataSource() datasource.DataSource {
+	return &mackerelAlertsDataSource{}
+}
+
+type mackerelAlertsDataSource struct {
+	Client *mackerel.Client
+}
+
+func (d *mackerelAlertsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alerts"
+}
+
+func (d *mackerelAlertsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemaAlertsDataSource()
+}
+
+func (d *mackerelAlertsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data mackerel.AlertsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Closed alerts are kept for a long time, so reading them needs a bound not to go through the whole history.
+	// `limit` is not enough since it counts the matching alerts, not the pages.
+	if !data.WithClosed.ValueBool() || !data.From.IsNull() {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		path.Root("with_closed"),
+		"Missing Attribute Configuration",
+		"`from` must be set to read closed alerts.",
+	)
+}
+
+func (d *mackerelAlertsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := retrieveClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	d.Client = client
+}
+
+func (d *mackerelAlertsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config mackerel.AlertsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := mackerel.ReadAlerts(ctx, d.Client, config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read alerts",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+var alertLogType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":           types.StringType,
+		"created_at":   types.Int64Type,
+		"status":       types.StringType,
+		"trigger":      types.StringType,
+		"monitor_id":   types.StringType,
+		"target_value": types.Float64Type,
+	},
+}
+
+var alertType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":         types.StringType,
+		"status":     types.StringType,
+		"monitor_id": types.StringType,
+		"type":       types.StringType,
+		"host_id":    types.StringType,
+		"value":      types.Float64Type,
+		"message":    types.StringType,
+		"reason":     types.StringType,
+		"memo":       types.StringType,
+		"opened_at":  types.Int64Type,
+		"closed_at":  types.Int64Type,
+		"logs":       types.ListType{ElemType: alertLogType},
+	},
+}
+
+func schemaAlertsDataSource() schema.Schema {
+	return schema.Schema{
+		Description: "This data source allows access to alerts which match all of the filters.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"with_closed": schema.BoolAttribute{
+				Description: "Whether to read closed alerts as well as open ones. Only open alerts are read by default. `from` is required to read closed alerts.",
+				Optional:    true,
+			},
+			"statuses": schema.SetAttribute{
+				Description: "The set of statuses of the alerts.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(mackerel.AlertStatusValidator()),
+				},
+			},
+			"monitor_ids": schema.SetAttribute{
+				Description: "The set of IDs of the monitors which raised the alerts.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"monitor_types": schema.SetAttribute{
+				Description: "The set of types of the monitors which raised the alerts.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(mackerel.AlertMonitorTypeValidator()),
+				},
+			},
+			"host_ids": schema.SetAttribute{
+				Description: "The set of IDs of the hosts which the alerts are raised for.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"service": schema.StringAttribute{
+				Description: "The name of the service. The alerts of its hosts and of the monitors which target it are read.",
+				Optional:    true,
+				Validators: []validator.String{
+					mackerel.ServiceNameValidator(),
+				},
+			},
+			"role_fullname": schema.StringAttribute{
+				Description: "The role in the form of `<service>:<role>`. The alerts of its hosts are read.",
+				Optional:    true,
+				Validators: []validator.String{
+					mackerel.RoleFullnameValidator(),
+					stringvalidator.ConflictsWith(path.MatchRoot("service")),
+				},
+			},
+			"from": schema.StringAttribute{
+				Description: "The alerts opened at or after this time are read, in RFC3339 format or epoch seconds.",
+				Optional:    true,
+				CustomType:  typeutil.TimeStringType{},
+			},
+			"to": schema.StringAttribute{
+				Description: "The alerts opened at or before this time are read, in RFC3339 format or epoch seconds.",
+				Optional:    true,
+				CustomType:  typeutil.TimeStringType{},
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of the alerts to read. All matching alerts are read by default.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"with_logs": schema.BoolAttribute{
+				Description: "Whether to read the log history of each alert into `logs`.",
+				Optional:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "The list of IDs of the alerts, from the newest one.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"alerts": schema.ListAttribute{
+				Description: "The list of the alerts, from the newest one.",
+				ElementType: alertType,
+				Computed:    true,
+			},
+		},
+	}
+}
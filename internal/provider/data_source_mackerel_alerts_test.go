@@ -0,0 +1,73 @@
+package provider_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/provider"
+)
+
+func Test_MackerelAlertsDataSource_schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	req := fwdatasource.SchemaRequest{}
+	resp := fwdatasource.SchemaResponse{}
+	provider.NewMackerelAlertsDataSource().Schema(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("schema diagnostics: %+v", resp.Diagnostics)
+	}
+
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema validation diagnostics: %+v", diags)
+	}
+}
+
+func TestAccDataSourceMackerelAlerts(t *testing.T) {
+	dsName := "data.mackerel_alerts.foo"
+	rand := acctest.RandString(5)
+	name := fmt.Sprintf("tf-service-%s", rand)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { preCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "mackerel_alerts" "foo" {
+  with_closed = true
+  limit       = 10
+}`,
+				ExpectError: regexp.MustCompile("`from` must be set to read closed alerts"),
+			},
+			{
+				Config: testAccDataSourceMackerelAlertsConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "id", "limit=10&service="+name+"&status=CRITICAL&withLogs=true"),
+					// The new service has no alerts.
+					resource.TestCheckResourceAttr(dsName, "ids.#", "0"),
+					resource.TestCheckResourceAttr(dsName, "alerts.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMackerelAlertsConfig(name string) string {
+	return fmt.Sprintf(`
+resource "mackerel_service" "foo" {
+  name = "%s"
+}
+
+data "mackerel_alerts" "foo" {
+  service   = mackerel_service.foo.name
+  statuses  = ["CRITICAL"]
+  limit     = 10
+  with_logs = true
+}
+`, name)
+}
@@ -3,22 +3,30 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/mackerel"
 )
 
 var (
-	_ datasource.DataSource              = (*mackerelDashboardDataSource)(nil)
-	_ datasource.DataSourceWithConfigure = (*mackerelDashboardDataSource)(nil)
+	_ datasource.DataSource                     = (*mackerelDashboardDataSource)(nil)
+	_ datasource.DataSourceWithConfigure        = (*mackerelDashboardDataSource)(nil)
+	_ datasource.DataSourceWithConfigValidators = (*mackerelDashboardDataSource)(nil)
 )
 
 type mackerelDashboardDataSource struct {
 	Client *mackerel.Client
 }
 
+type mackerelDashboardDataSourceModel struct {
+	mackerel.DashboardModel
+	AllLabels map[string]string `tfsdk:"all_labels"`
+}
+
 func NewMackerelDashboardDataSource() datasource.DataSource {
 	return &mackerelDashboardDataSource{}
 }
@@ -31,6 +39,15 @@ func (d *mackerelDashboardDataSource) Schema(_ context.Context, _ datasource.Sch
 	resp.Schema = schemaDashboardDataSource()
 }
 
+func (d *mackerelDashboardDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("labels"),
+		),
+	}
+}
+
 func (d *mackerelDashboardDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	client, diags := retrieveClient(ctx, req.ProviderData)
 	resp.Diagnostics.Append(diags...)
@@ -41,13 +58,19 @@ func (d *mackerelDashboardDataSource) Configure(ctx context.Context, req datasou
 }
 
 func (d *mackerelDashboardDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var config mackerel.DashboardModel
+	var config mackerelDashboardDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data, err := mackerel.ReadDashboard(ctx, d.Client, config.ID.ValueString())
+	var model mackerel.DashboardModel
+	var err error
+	if config.ID.IsNull() {
+		model, err = mackerel.FindDashboardByLabels(ctx, d.Client, config.Labels)
+	} else {
+		model, err = mackerel.ReadDashboard(ctx, d.Client, config.ID.ValueString())
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to read a dashboard",
@@ -56,6 +79,13 @@ func (d *mackerelDashboardDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 
+	// Keep the labels as configured since they are the filter, and expose all of them separately.
+	data := mackerelDashboardDataSourceModel{
+		DashboardModel: model,
+		AllLabels:      model.Labels,
+	}
+	data.Labels = config.Labels
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -75,7 +105,8 @@ func schemaDashboardDataSource() schema.Schema {
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: schemaDashboardIDDesc,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"title": schema.StringAttribute{
 				Description: schemaDashboardTitleDesc,
@@ -85,6 +116,16 @@ func schemaDashboardDataSource() schema.Schema {
 				Description: schemaDashboardMemoDesc,
 				Computed:    true,
 			},
+			"labels": schema.MapAttribute{
+				Description: "A map of labels to find the dashboard by. The only dashboard which has all of these labels is read.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"all_labels": schema.MapAttribute{
+				Description: schemaDashboardLabelsDesc,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			"url_path": schema.StringAttribute{
 				Description: schemaDashboardURLPathDesc,
 				Computed:    true,
@@ -3,16 +3,19 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/mackerel"
 )
 
 var (
-	_ datasource.DataSource              = (*mackerelDowntimeDataSource)(nil)
-	_ datasource.DataSourceWithConfigure = (*mackerelDowntimeDataSource)(nil)
+	_ datasource.DataSource                     = (*mackerelDowntimeDataSource)(nil)
+	_ datasource.DataSourceWithConfigure        = (*mackerelDowntimeDataSource)(nil)
+	_ datasource.DataSourceWithConfigValidators = (*mackerelDowntimeDataSource)(nil)
 )
 
 func NewMackerelDowntimeDataSource() datasource.DataSource {
@@ -23,6 +26,11 @@ type mackerelDowntimeDataSource struct {
 	Client *mackerel.Client
 }
 
+type mackerelDowntimeDataSourceModel struct {
+	mackerel.DowntimeModel
+	AllLabels map[string]string `tfsdk:"all_labels"`
+}
+
 func (d *mackerelDowntimeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_downtime"
 }
@@ -31,6 +39,15 @@ func (d *mackerelDowntimeDataSource) Schema(_ context.Context, _ datasource.Sche
 	resp.Schema = schemaDowntimeDataSource()
 }
 
+func (d *mackerelDowntimeDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("labels"),
+		),
+	}
+}
+
 func (d *mackerelDowntimeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	client, diags := retrieveClient(ctx, req.ProviderData)
 	resp.Diagnostics.Append(diags...)
@@ -41,13 +58,19 @@ func (d *mackerelDowntimeDataSource) Configure(ctx context.Context, req datasour
 }
 
 func (d *mackerelDowntimeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var config mackerel.DowntimeModel
+	var config mackerelDowntimeDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data, err := mackerel.ReadDowntime(ctx, d.Client, config.ID.ValueString())
+	var model *mackerel.DowntimeModel
+	var err error
+	if config.ID.IsNull() {
+		model, err = mackerel.FindDowntimeByLabels(ctx, d.Client, config.Labels)
+	} else {
+		model, err = mackerel.ReadDowntime(ctx, d.Client, config.ID.ValueString())
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to read a downtime",
@@ -56,6 +79,13 @@ func (d *mackerelDowntimeDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
+	// Keep the labels as configured since they are the filter, and expose all of them separately.
+	data := mackerelDowntimeDataSourceModel{
+		DowntimeModel: *model,
+		AllLabels:     model.Labels,
+	}
+	data.Labels = config.Labels
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -65,7 +95,8 @@ func schemaDowntimeDataSource() schema.Schema {
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: schemaDowntimeIDDesc,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"name": schema.StringAttribute{
 				Description: schemaDowntimeNameDesc,
@@ -75,6 +106,16 @@ func schemaDowntimeDataSource() schema.Schema {
 				Description: schemaDowntimeMemoDesc,
 				Computed:    true,
 			},
+			"labels": schema.MapAttribute{
+				Description: "A map of labels to find the downtime by. The only downtime which has all of these labels is read.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"all_labels": schema.MapAttribute{
+				Description: schemaDowntimeLabelsDesc,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			"start": schema.Int64Attribute{
 				Description: schemaDowntimeStartDesc,
 				Computed:    true,
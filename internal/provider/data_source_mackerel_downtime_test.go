@@ -129,3 +129,43 @@ func TestAccDataSourceMackerelDowntimeNotMatchAnyDowntime(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDataSourceMackerelDowntimeByLabels(t *testing.T) {
+	dsName := "data.mackerel_downtime.foo"
+	rand := acctest.RandString(5)
+	name := fmt.Sprintf("tf-downtime-%s", rand)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { preCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "mackerel_downtime" "foo" {
+  name = "%s"
+  start = 1735707600
+  duration = 3600
+  labels = {
+    test = "%s"
+    team = "sre"
+  }
+}
+
+data "mackerel_downtime" "foo" {
+  labels = {
+    test = mackerel_downtime.foo.labels.test
+  }
+}
+`, name, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dsName, "id", "mackerel_downtime.foo", "id"),
+					resource.TestCheckResourceAttr(dsName, "labels.%", "1"),
+					resource.TestCheckResourceAttr(dsName, "labels.test", rand),
+					resource.TestCheckResourceAttr(dsName, "all_labels.%", "2"),
+					resource.TestCheckResourceAttr(dsName, "all_labels.test", rand),
+					resource.TestCheckResourceAttr(dsName, "all_labels.team", "sre"),
+				),
+			},
+		},
+	})
+}
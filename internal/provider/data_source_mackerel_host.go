@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/mackerel"
+)
+
+var (
+	_ datasource.DataSource                     = (*mackerelHostDataSource)(nil)
+	_ datasource.DataSourceWithConfigure        = (*mackerelHostDataSource)(nil)
+	_ datasource.DataSourceWithConfigValidators = (*mackerelHostDataSource)(nil)
+)
+
+func NewMackerelHostDataSource() datasource.DataSource {
+	return &mackerelHostDataSource{}
+}
+
+type mackerelHostDataSource struct {
+	Client *mackerel.Client
+}
+
+func (d *mackerelHostDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host"
+}
+
+func (d *mackerelHostDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemaHostDataSource()
+}
+
+func (d *mackerelHostDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("custom_identifier"),
+		),
+	}
+}
+
+func (d *mackerelHostDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := retrieveClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	d.Client = client
+}
+
+func (d *mackerelHostDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config mackerel.HostDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := mackerel.FindHost(ctx, d.Client, config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read a host",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+var hostInterfaceType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":           types.StringType,
+		"ip_address":     types.StringType,
+		"ipv4_addresses": types.ListType{ElemType: types.StringType},
+		"ipv6_addresses": types.ListType{ElemType: types.StringType},
+		"mac_address":    types.StringType,
+	},
+}
+
+const schemaHostInterfacesDesc = "The network interfaces of the host."
+
+func schemaHostDataSource() schema.Schema {
+	return schema.Schema{
+		Description: "This data source allows access to details of a specific host, by the ID, the name or the custom identifier.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: schemaHostIDDesc,
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: schemaHostNameDesc,
+				Optional:    true,
+				Computed:    true,
+			},
+			"custom_identifier": schema.StringAttribute{
+				Description: schemaHostCustomIdentifierDesc,
+				Optional:    true,
+				Computed:    true,
+			},
+			"display_name": schema.StringAttribute{
+				Description: schemaHostDisplayNameDesc,
+				Computed:    true,
+			},
+			"memo": schema.StringAttribute{
+				Description: schemaHostMemoDesc,
+				Computed:    true,
+			},
+			"role_fullnames": schema.SetAttribute{
+				Description: schemaHostRoleFullnamesDesc,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: schemaHostStatusDesc,
+				Computed:    true,
+			},
+			"interfaces": schema.ListAttribute{
+				Description: schemaHostInterfacesDesc,
+				ElementType: hostInterfaceType,
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/mackerel"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = (*mackerelHostMetadataDataSource)(nil)
+)
+
+func NewMackerelHostMetadataDataSource() datasource.DataSource {
+	return &mackerelHostMetadataDataSource{}
+}
+
+type mackerelHostMetadataDataSource struct {
+	Client *mackerel.Client
+}
+
+func (d *mackerelHostMetadataDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host_metadata"
+}
+
+func (d *mackerelHostMetadataDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source accesses to details of a specific Host Metadata.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"host_id": schema.StringAttribute{
+				Description: schemaHostIDDesc,
+				Required:    true,
+			},
+			"namespace": schema.StringAttribute{
+				Description: "The identifier for the metadata.",
+				Required:    true,
+			},
+			"metadata_json": schema.StringAttribute{
+				Description: "The arbitrary JSON data for the host.",
+				Computed:    true,
+				CustomType:  jsontypes.NormalizedType{},
+			},
+		},
+	}
+}
+
+func (d *mackerelHostMetadataDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := retrieveClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	d.Client = client
+}
+
+func (d *mackerelHostMetadataDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config mackerel.HostMetadataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostID := config.HostID.ValueString()
+	namespace := config.Namespace.ValueString()
+	data, err := mackerel.ReadHostMetadata(ctx, d.Client, hostID, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to read Host Metadata: host_id=%s namespace=%s", hostID, namespace),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,72 @@
+package provider_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/provider"
+)
+
+func Test_MackerelHostMetadataDataSource_schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	req := fwdatasource.SchemaRequest{}
+	resp := fwdatasource.SchemaResponse{}
+	provider.NewMackerelHostMetadataDataSource().Schema(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("schema diagnostics: %+v", resp.Diagnostics)
+	}
+
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema validation diagnostics: %+v", diags)
+	}
+}
+
+func TestAccDataSourceMackerelHostMetadata(t *testing.T) {
+	dsName := "data.mackerel_host_metadata.foo"
+	rand := acctest.RandString(5)
+	host := fmt.Sprintf("tf-host-%s", rand)
+	namespace := fmt.Sprintf("tf-namespace-%s", rand)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { preCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMackerelHostMetadataConfig(host, namespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dsName, "id", "mackerel_host_metadata.foo", "id"),
+					resource.TestCheckResourceAttrPair(dsName, "host_id", "mackerel_host.foo", "id"),
+					resource.TestCheckResourceAttr(dsName, "namespace", namespace),
+					resource.TestCheckResourceAttr(dsName, "metadata_json", `{"owner":"sre"}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMackerelHostMetadataConfig(host, namespace string) string {
+	return fmt.Sprintf(`
+resource "mackerel_host" "foo" {
+  name = "%s"
+}
+
+resource "mackerel_host_metadata" "foo" {
+  host_id = mackerel_host.foo.id
+  namespace = "%s"
+  metadata_json = jsonencode({
+    owner = "sre"
+  })
+}
+
+data "mackerel_host_metadata" "foo" {
+  depends_on = [mackerel_host_metadata.foo]
+  host_id = mackerel_host_metadata.foo.host_id
+  namespace = mackerel_host_metadata.foo.namespace
+}`, host, namespace)
+}
@@ -0,0 +1,75 @@
+package provider_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/provider"
+)
+
+func Test_MackerelHostDataSource_schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	req := fwdatasource.SchemaRequest{}
+	resp := fwdatasource.SchemaResponse{}
+	provider.NewMackerelHostDataSource().Schema(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("schema diagnostics: %+v", resp.Diagnostics)
+	}
+
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema validation diagnostics: %+v", diags)
+	}
+}
+
+func TestAccDataSourceMackerelHost(t *testing.T) {
+	rand := acctest.RandString(5)
+	name := fmt.Sprintf("tf-host-%s", rand)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { preCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMackerelHostConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.mackerel_host.by_id", "id", "mackerel_host.foo", "id"),
+					resource.TestCheckResourceAttr("data.mackerel_host.by_id", "name", name),
+					resource.TestCheckResourceAttr("data.mackerel_host.by_id", "display_name", "Terraform Host"),
+					resource.TestCheckResourceAttr("data.mackerel_host.by_id", "status", "working"),
+					resource.TestCheckResourceAttr("data.mackerel_host.by_id", "interfaces.#", "0"),
+					resource.TestCheckResourceAttrPair("data.mackerel_host.by_name", "id", "mackerel_host.foo", "id"),
+					resource.TestCheckResourceAttrPair("data.mackerel_host.by_custom_identifier", "id", "mackerel_host.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMackerelHostConfig(name string) string {
+	return fmt.Sprintf(`
+resource "mackerel_host" "foo" {
+  name = "%s"
+  display_name = "Terraform Host"
+  custom_identifier = "%s.example.com"
+  status = "working"
+}
+
+data "mackerel_host" "by_id" {
+  id = mackerel_host.foo.id
+}
+
+data "mackerel_host" "by_name" {
+  name = mackerel_host.foo.name
+}
+
+data "mackerel_host" "by_custom_identifier" {
+  custom_identifier = mackerel_host.foo.custom_identifier
+}
+`, name, name)
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/mackerel"
+)
+
+var (
+	_ datasource.DataSource              = (*mackerelHostsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*mackerelHostsDataSource)(nil)
+)
+
+func NewMackerelHostsDataSource() datasource.DataSource {
+	return &mackerelHostsDataSource{}
+}
+
+type mackerelHostsDataSource struct {
+	Client *mackerel.Client
+}
+
+func (d *mackerelHostsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hosts"
+}
+
+func (d *mackerelHostsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemaHostsDataSource()
+}
+
+func (d *mackerelHostsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := retrieveClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	d.Client = client
+}
+
+func (d *mackerelHostsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config mackerel.HostsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := mackerel.ReadHosts(ctx, d.Client, config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read hosts",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func schemaHostsDataSource() schema.Schema {
+	return schema.Schema{
+		Description: "This data source allows access to hosts which match all of the filters.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"service": schema.StringAttribute{
+				Description: "The name of the service which the hosts belong to.",
+				Optional:    true,
+				Validators: []validator.String{
+					mackerel.ServiceNameValidator(),
+				},
+			},
+			"role_fullname": schema.StringAttribute{
+				Description: "The role which the hosts belong to, in the form of `<service>:<role>`.",
+				Optional:    true,
+				Validators: []validator.String{
+					mackerel.RoleFullnameValidator(),
+					stringvalidator.ConflictsWith(path.MatchRoot("service")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the hosts.",
+				Optional:    true,
+			},
+			"statuses": schema.SetAttribute{
+				Description: "The set of statuses of the hosts. Hosts whose status is `working` or `standby` are read by default.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(mackerel.HostStatusValidator()),
+				},
+			},
+			"custom_identifier": schema.StringAttribute{
+				Description: "The custom identifier of the hosts.",
+				Optional:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "The list of IDs of the hosts.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"hosts": schema.ListAttribute{
+				Description: "The list of the hosts.",
+				Computed:    true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"id":                types.StringType,
+						"name":              types.StringType,
+						"display_name":      types.StringType,
+						"custom_identifier": types.StringType,
+						"memo":              types.StringType,
+						"role_fullnames":    types.SetType{ElemType: types.StringType},
+						"status":            types.StringType,
+						"interfaces":        types.ListType{ElemType: hostInterfaceType},
+					},
+				},
+			},
+		},
+	}
+}
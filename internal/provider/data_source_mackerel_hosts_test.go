@@ -0,0 +1,74 @@
+package provider_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/provider"
+)
+
+func Test_MackerelHostsDataSource_schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	req := fwdatasource.SchemaRequest{}
+	resp := fwdatasource.SchemaResponse{}
+	provider.NewMackerelHostsDataSource().Schema(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("schema diagnostics: %+v", resp.Diagnostics)
+	}
+
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema validation diagnostics: %+v", diags)
+	}
+}
+
+func TestAccDataSourceMackerelHosts(t *testing.T) {
+	dsName := "data.mackerel_hosts.foo"
+	rand := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { preCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMackerelHostsConfig(rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dsName, "hosts.#", "2"),
+					resource.TestCheckResourceAttr(dsName, "hosts.0.role_fullnames.#", "1"),
+					resource.TestCheckResourceAttr(dsName, "hosts.0.status", "working"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMackerelHostsConfig(rand string) string {
+	return fmt.Sprintf(`
+resource "mackerel_service" "foo" {
+  name = "tf-service-%s"
+}
+
+resource "mackerel_role" "foo" {
+  service = mackerel_service.foo.name
+  name = "tf-role-%s"
+}
+
+resource "mackerel_host" "foo" {
+  count = 2
+  name = "tf-host-%s-${count.index}"
+  role_fullnames = [mackerel_role.foo.id]
+  status = "working"
+}
+
+data "mackerel_hosts" "foo" {
+  role_fullname = mackerel_role.foo.id
+  depends_on = [mackerel_host.foo]
+}
+`, rand, rand, rand)
+}
@@ -3,23 +3,31 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/mackerel"
 	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/typeutil"
 )
 
 var (
-	_ datasource.DataSource              = (*mackerelMonitorDataSource)(nil)
-	_ datasource.DataSourceWithConfigure = (*mackerelMonitorDataSource)(nil)
+	_ datasource.DataSource                     = (*mackerelMonitorDataSource)(nil)
+	_ datasource.DataSourceWithConfigure        = (*mackerelMonitorDataSource)(nil)
+	_ datasource.DataSourceWithConfigValidators = (*mackerelMonitorDataSource)(nil)
 )
 
 type mackerelMonitorDataSource struct {
 	Client *mackerel.Client
 }
 
+type mackerelMonitorDataSourceModel struct {
+	mackerel.MonitorModel
+	AllLabels map[string]string `tfsdk:"all_labels"`
+}
+
 func NewMackerelMonitorDataSource() datasource.DataSource {
 	return &mackerelMonitorDataSource{}
 }
@@ -33,7 +41,8 @@ func (d *mackerelMonitorDataSource) Schema(_ context.Context, _ datasource.Schem
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: schemaMonitorIDDesc,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"name": schema.StringAttribute{
 				Description: schemaMonitorNameDesc,
@@ -43,6 +52,16 @@ func (d *mackerelMonitorDataSource) Schema(_ context.Context, _ datasource.Schem
 				Description: schemaMonitorMemoDesc,
 				Computed:    true,
 			},
+			"labels": schema.MapAttribute{
+				Description: "A map of labels to find the monitor by. The only monitor which has all of these labels is read.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"all_labels": schema.MapAttribute{
+				Description: schemaMonitorLabelsDesc,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			"is_mute": schema.BoolAttribute{
 				Description: schemaMonitorIsMuteDesc,
 				Computed:    true,
@@ -176,6 +195,15 @@ func (d *mackerelMonitorDataSource) Schema(_ context.Context, _ datasource.Schem
 	}
 }
 
+func (d *mackerelMonitorDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("labels"),
+		),
+	}
+}
+
 func (d *mackerelMonitorDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	client, diags := retrieveClient(ctx, req.ProviderData)
 	resp.Diagnostics.Append(diags...)
@@ -186,13 +214,20 @@ func (d *mackerelMonitorDataSource) Configure(ctx context.Context, req datasourc
 }
 
 func (d *mackerelMonitorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data mackerel.MonitorModel
-	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	var config mackerelMonitorDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if err := data.Read(ctx, d.Client); err != nil {
+	model := config.MonitorModel
+	var err error
+	if model.ID.IsNull() {
+		model, err = mackerel.FindMonitorByLabels(ctx, d.Client, config.Labels)
+	} else {
+		err = model.Read(ctx, d.Client)
+	}
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to read Monitor",
 			err.Error(),
@@ -200,5 +235,12 @@ func (d *mackerelMonitorDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
+	// Keep the labels as configured since they are the filter, and expose all of them separately.
+	data := mackerelMonitorDataSourceModel{
+		MonitorModel: model,
+		AllLabels:    model.Labels,
+	}
+	data.Labels = config.Labels
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
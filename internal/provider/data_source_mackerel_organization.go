@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/mackerel"
+)
+
+var (
+	_ datasource.DataSource              = (*mackerelOrganizationDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*mackerelOrganizationDataSource)(nil)
+)
+
+func NewMackerelOrganizationDataSource() datasource.DataSource {
+	return &mackerelOrganizationDataSource{}
+}
+
+type mackerelOrganizationDataSource struct {
+	Client *mackerel.Client
+}
+
+func (d *mackerelOrganizationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+func (d *mackerelOrganizationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This data source allows access to the organization which the API key belongs to.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the organization, which is used in the URLs of Mackerel.",
+				Computed:    true,
+			},
+			"display_name": schema.StringAttribute{
+				Description: "The display name of the organization. It is empty if not set.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *mackerelOrganizationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := retrieveClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	d.Client = client
+}
+
+func (d *mackerelOrganizationDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	data, err := mackerel.ReadOrganization(ctx, d.Client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read the organization",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/mackerel"
+)
+
+var (
+	_ datasource.DataSource                     = (*mackerelUserDataSource)(nil)
+	_ datasource.DataSourceWithConfigure        = (*mackerelUserDataSource)(nil)
+	_ datasource.DataSourceWithConfigValidators = (*mackerelUserDataSource)(nil)
+)
+
+func NewMackerelUserDataSource() datasource.DataSource {
+	return &mackerelUserDataSource{}
+}
+
+type mackerelUserDataSource struct {
+	Client *mackerel.Client
+}
+
+func (d *mackerelUserDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *mackerelUserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemaUserDataSource()
+}
+
+func (d *mackerelUserDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("email"),
+			path.MatchRoot("screen_name"),
+		),
+	}
+}
+
+func (d *mackerelUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := retrieveClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	d.Client = client
+}
+
+func (d *mackerelUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config mackerel.UserModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := mackerel.FindUser(ctx, d.Client, config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read a user",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+const (
+	schemaUserIDDesc           = "The ID of the user."
+	schemaUserScreenNameDesc   = "The screen name of the user."
+	schemaUserEmailDesc        = "The email address of the user."
+	schemaUserAuthorityDesc    = "The authority of the user in the organization. One of `owner`, `manager`, `collaborator` and `viewer`."
+	schemaUserIsMFAEnabledDesc = "Whether the user has enabled two-factor authentication."
+)
+
+var userType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":             types.StringType,
+		"screen_name":    types.StringType,
+		"email":          types.StringType,
+		"authority":      types.StringType,
+		"is_mfa_enabled": types.BoolType,
+	},
+}
+
+func schemaUserDataSource() schema.Schema {
+	return schema.Schema{
+		Description: "This data source allows access to details of a specific user in the organization, by the email or the screen name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: schemaUserIDDesc,
+				Computed:    true,
+			},
+			"screen_name": schema.StringAttribute{
+				Description: schemaUserScreenNameDesc,
+				Optional:    true,
+				Computed:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: schemaUserEmailDesc,
+				Optional:    true,
+				Computed:    true,
+			},
+			"authority": schema.StringAttribute{
+				Description: schemaUserAuthorityDesc,
+				Computed:    true,
+			},
+			"is_mfa_enabled": schema.BoolAttribute{
+				Description: schemaUserIsMFAEnabledDesc,
+				Computed:    true,
+			},
+		},
+	}
+}
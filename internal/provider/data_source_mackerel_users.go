@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/mackerel"
+)
+
+var (
+	_ datasource.DataSource              = (*mackerelUsersDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*mackerelUsersDataSource)(nil)
+)
+
+func NewMackerelUsersDataSource() datasource.DataSource {
+	return &mackerelUsersDataSource{}
+}
+
+type mackerelUsersDataSource struct {
+	Client *mackerel.Client
+}
+
+func (d *mackerelUsersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *mackerelUsersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemaUsersDataSource()
+}
+
+func (d *mackerelUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, diags := retrieveClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	d.Client = client
+}
+
+func (d *mackerelUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config mackerel.UsersModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := mackerel.ReadUsers(ctx, d.Client, config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read users",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func schemaUsersDataSource() schema.Schema {
+	return schema.Schema{
+		Description: "This data source allows access to users in the organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"authorities": schema.SetAttribute{
+				Description: "The set of authorities of the users. All users are read by default.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(mackerel.UserAuthorityValidator()),
+				},
+			},
+			"ids": schema.ListAttribute{
+				Description: "The list of IDs of the users.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"users": schema.ListAttribute{
+				Description: "The list of the users.",
+				ElementType: userType,
+				Computed:    true,
+			},
+		},
+	}
+}
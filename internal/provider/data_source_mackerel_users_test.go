@@ -0,0 +1,60 @@
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/provider"
+)
+
+func Test_MackerelUsersDataSource_schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	req := fwdatasource.SchemaRequest{}
+	resp := fwdatasource.SchemaResponse{}
+	provider.NewMackerelUsersDataSource().Schema(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("schema diagnostics: %+v", resp.Diagnostics)
+	}
+
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema validation diagnostics: %+v", diags)
+	}
+}
+
+func TestAccDataSourceMackerelUsers(t *testing.T) {
+	dsName := "data.mackerel_users.owners"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { preCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMackerelUsersConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "id", "authority=owner"),
+					resource.TestCheckResourceAttrSet(dsName, "ids.0"),
+					resource.TestCheckResourceAttr(dsName, "users.0.authority", "owner"),
+					// Look up the first owner by the email.
+					resource.TestCheckResourceAttrPair("data.mackerel_user.owner", "id", dsName, "ids.0"),
+					resource.TestCheckResourceAttrPair("data.mackerel_user.owner", "screen_name", dsName, "users.0.screen_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMackerelUsersConfig() string {
+	return `
+data "mackerel_users" "owners" {
+  authorities = ["owner"]
+}
+
+data "mackerel_user" "owner" {
+  email = data.mackerel_users.owners.users[0].email
+}
+`
+}
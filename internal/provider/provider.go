@@ -4,27 +4,37 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/mackerel"
 	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/validatorutil"
 )
 
-type mackerelProvider struct{}
+type mackerelProvider struct {
+	version string
+}
 
 var _ provider.Provider = (*mackerelProvider)(nil)
 
-func New() provider.Provider {
-	return &mackerelProvider{}
+func New(version string) provider.Provider {
+	return &mackerelProvider{version: version}
 }
 
 func (m *mackerelProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "mackerel"
+	resp.Version = m.version
 }
 
 func (m *mackerelProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
@@ -35,12 +45,93 @@ func (m *mackerelProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"read_api_key": schema.StringAttribute{
+				Description: "Mackerel API Key used for reads. It can be a read-only API key.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"write_api_key": schema.StringAttribute{
+				Description: "Mackerel API Key used for writes. Without this or api_key, creating, updating and deleting objects fail.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"api_key_file": schema.StringAttribute{
+				Description: "The path to a file which contains Mackerel API Key.",
+				Optional:    true,
+			},
+			"api_key_command": schema.ListAttribute{
+				Description: "The command and its arguments which print Mackerel API Key to the standard output.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+			},
+			"mackerel_agent_config_path": schema.StringAttribute{
+				Description: "The path to the config file of mackerel-agent to read `apikey` and `apibase` from.",
+				Optional:    true,
+			},
 			"api_base": schema.StringAttribute{
 				Description: "Mackerel API BASE URL",
 				Optional:    true,
 				Sensitive:   true,
 				Validators:  []validator.String{validatorutil.IsURLWithHTTPorHTTPS()},
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: "The maximum number of retries for rate-limited or failed API requests. Defaults to 4.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"retry_wait_min": schema.Int64Attribute{
+				Description: "The minimum time in seconds to wait before retrying an API request. Defaults to 1.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"retry_wait_max": schema.Int64Attribute{
+				Description: "The maximum time in seconds to wait before retrying an API request. Defaults to 30.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"requests_per_minute": schema.Int64Attribute{
+				Description: "The maximum number of API requests per minute shared by all resources and data sources. Unlimited by default.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "The URL of the proxy for API requests. By default, the proxy is taken from HTTPS_PROXY and NO_PROXY environment variables.",
+				Optional:    true,
+				Validators:  []validator.String{validatorutil.IsURLWithHTTPorHTTPS()},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "The path to a PEM file of CA certificates which are trusted in addition to the system ones.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_pem"))},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM-encoded CA certificates which are trusted in addition to the system ones.",
+				Optional:    true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Whether to skip verifying the TLS certificate of the server. It is insecure and only for debugging.",
+				Optional:    true,
+			},
+			"extra_headers": schema.MapAttribute{
+				Description: "Additional HTTP headers sent with every API request.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"expected_organization": schema.StringAttribute{
+				Description: "The name of the organization which the API key must belong to. If it does not match, the provider fails to configure.",
+				Optional:    true,
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "A prefix prepended to the names of monitors, dashboards, downtimes, channels, notification groups and alert group settings.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"managed_marker": schema.StringAttribute{
+				Description: "A marker written to the memos of monitors, dashboards, downtimes and alert group settings, to tell the objects managed by Terraform.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
 		},
 	}
 }
@@ -54,12 +145,58 @@ func (m *mackerelProvider) Configure(ctx context.Context, req provider.Configure
 
 	config := mackerel.NewClientConfigFromEnv()
 	// merge config
-	if config.APIKey.IsNull() {
-		config.APIKey = schemaConfig.APIKey
-	}
+	config.MergeAPIKeySources(schemaConfig)
 	if config.APIBase.IsNull() {
 		config.APIBase = schemaConfig.APIBase
 	}
+	config.MaxRetries = schemaConfig.MaxRetries
+	config.RetryWaitMin = schemaConfig.RetryWaitMin
+	config.RetryWaitMax = schemaConfig.RetryWaitMax
+	config.RequestsPerMinute = schemaConfig.RequestsPerMinute
+	config.ExpectedOrganization = schemaConfig.ExpectedOrganization
+	config.ProxyURL = schemaConfig.ProxyURL
+	config.CACertFile = schemaConfig.CACertFile
+	config.CACertPEM = schemaConfig.CACertPEM
+	config.InsecureSkipVerify = schemaConfig.InsecureSkipVerify
+	config.ExtraHeaders = schemaConfig.ExtraHeaders
+	config.ManagedMarker = schemaConfig.ManagedMarker
+	config.NamePrefix = schemaConfig.NamePrefix
+	config.UserAgent = mackerel.UserAgent(m.version, req.TerraformVersion)
+
+	if config.InsecureSkipVerify.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS Certificate Verification Disabled",
+			"The provider does not verify the TLS certificate of Mackerel API, so the API key and other data may be exposed to a man-in-the-middle. "+
+				"Use ca_cert_file or ca_cert_pem to trust your proxy instead.",
+		)
+	}
+
+	if err := config.ResolveAPIKey(ctx); err != nil {
+		if errors.Is(err, mackerel.ErrNoAPIKey) {
+			resp.Diagnostics.AddError(
+				"No API Key",
+				err.Error()+" Set one of api_key, api_key_file, api_key_command, mackerel_agent_config_path, "+
+					"read_api_key, write_api_key, or the MACKEREL_APIKEY environment variable.",
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to load API Key",
+				err.Error(),
+			)
+		}
+		return
+	}
+	tflog.Info(ctx, "Using the Mackerel API key", map[string]any{
+		"api_key_source": config.APIKeySource(),
+	})
+	if ignored := config.IgnoredAPIKeySources(); len(ignored) > 0 {
+		resp.Diagnostics.AddWarning(
+			"API Key Source Ignored",
+			fmt.Sprintf("The API key from %s is ignored, and the one from %s is used instead. "+
+				"Remove the ignored source to silence this warning.", strings.Join(ignored, " and "), config.APIKeySource()),
+		)
+	}
 
 	client, err := config.NewClient()
 	if err != nil {
@@ -77,6 +214,20 @@ func (m *mackerelProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	if expected := config.ExpectedOrganization.ValueString(); expected != "" {
+		if err := client.VerifyOrg(ctx, expected); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expected_organization"),
+				"Unexpected Mackerel Organization",
+				err.Error(),
+			)
+			return
+		}
+		tflog.Info(ctx, "Verified the Mackerel organization", map[string]any{
+			"organization": expected,
+		})
+	}
+
 	resp.ResourceData = client
 	resp.DataSourceData = client
 }
@@ -85,10 +236,17 @@ func (m *mackerelProvider) Resources(context.Context) []func() resource.Resource
 	return []func() resource.Resource{
 		NewMackerelAlertGroupSettingResource,
 		NewMackerelAWSIntegrationResource,
+		NewMackerelAWSIntegrationExternalIDResource,
 		NewMackerelChannelResource,
 		NewMackerelDashboardResource,
 		NewMackerelDefaultNotificationGroupResource,
 		NewMackerelDowntimeResource,
+		NewMackerelGraphAnnotationResource,
+		NewMackerelGraphDefinitionResource,
+		NewMackerelHostResource,
+		NewMackerelHostMetadataResource,
+		NewMackerelHostRoleAttachmentResource,
+		NewMackerelInvitationResource,
 		NewMackerelMonitorResource,
 		NewMackerelNotificationGroupResource,
 		NewMackerelRoleResource,
@@ -101,17 +259,24 @@ func (m *mackerelProvider) Resources(context.Context) []func() resource.Resource
 func (m *mackerelProvider) DataSources(context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewMackerelAlertGroupSettingDataSource,
+		NewMackerelAlertsDataSource,
 		NewMackerelAWSIntegrationDataSource,
 		NewMackerelChannelDataSource,
 		NewMackerelDashboardDataSource,
 		NewMackerelDowntimeDataSource,
+		NewMackerelHostDataSource,
+		NewMackerelHostMetadataDataSource,
+		NewMackerelHostsDataSource,
 		NewMackerelMonitorDataSource,
 		NewMackerelNotificationGroupDataSource,
+		NewMackerelOrganizationDataSource,
 		NewMackerelRoleDataSource,
 		NewMackerelRoleMetadataDataSource,
 		NewMackerelServiceDataSource,
 		NewMackerelServiceMetadataDataSource,
 		NewMackerelServiceMetricNamesDataSource,
+		NewMackerelUserDataSource,
+		NewMackerelUsersDataSource,
 	}
 }
 
@@ -15,7 +15,7 @@ import (
 )
 
 var protoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"mackerel": providerserver.NewProtocol6WithError(provider.New()),
+	"mackerel": providerserver.NewProtocol6WithError(provider.New("test")),
 }
 
 func preCheck(t *testing.T) {
@@ -36,7 +36,7 @@ func TestMackerelProvider_schema(t *testing.T) {
 
 	req := fwprovider.SchemaRequest{}
 	resp := &fwprovider.SchemaResponse{}
-	provider.New().Schema(ctx, req, resp)
+	provider.New("test").Schema(ctx, req, resp)
 	if resp.Diagnostics.HasError() {
 		t.Fatalf("Schema: %+v", resp.Diagnostics)
 	}
@@ -52,7 +52,7 @@ func TestMackerelProvider_Configure_WithConfigOnly(t *testing.T) {
 	t.Setenv("MACKEREL_APIKEY", "")
 
 	ctx := context.Background()
-	p := provider.New()
+	p := provider.New("test")
 
 	// Get schema
 	schemaReq := fwprovider.SchemaRequest{}
@@ -66,13 +66,47 @@ func TestMackerelProvider_Configure_WithConfigOnly(t *testing.T) {
 	configValue := tftypes.NewValue(
 		tftypes.Object{
 			AttributeTypes: map[string]tftypes.Type{
-				"api_key":  tftypes.String,
-				"api_base": tftypes.String,
+				"api_key":                    tftypes.String,
+				"read_api_key":               tftypes.String,
+				"write_api_key":              tftypes.String,
+				"api_key_file":               tftypes.String,
+				"api_key_command":            tftypes.List{ElementType: tftypes.String},
+				"mackerel_agent_config_path": tftypes.String,
+				"api_base":                   tftypes.String,
+				"max_retries":                tftypes.Number,
+				"retry_wait_min":             tftypes.Number,
+				"retry_wait_max":             tftypes.Number,
+				"requests_per_minute":        tftypes.Number,
+				"expected_organization":      tftypes.String,
+				"proxy_url":                  tftypes.String,
+				"ca_cert_file":               tftypes.String,
+				"ca_cert_pem":                tftypes.String,
+				"insecure_skip_verify":       tftypes.Bool,
+				"extra_headers":              tftypes.Map{ElementType: tftypes.String},
+				"managed_marker":             tftypes.String,
+				"name_prefix":                tftypes.String,
 			},
 		},
 		map[string]tftypes.Value{
-			"api_key":  tftypes.NewValue(tftypes.String, "test_api_key_from_config"),
-			"api_base": tftypes.NewValue(tftypes.String, nil),
+			"api_key":                    tftypes.NewValue(tftypes.String, "test_api_key_from_config"),
+			"read_api_key":               tftypes.NewValue(tftypes.String, nil),
+			"write_api_key":              tftypes.NewValue(tftypes.String, nil),
+			"api_key_file":               tftypes.NewValue(tftypes.String, nil),
+			"api_key_command":            tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			"mackerel_agent_config_path": tftypes.NewValue(tftypes.String, nil),
+			"api_base":                   tftypes.NewValue(tftypes.String, nil),
+			"max_retries":                tftypes.NewValue(tftypes.Number, nil),
+			"retry_wait_min":             tftypes.NewValue(tftypes.Number, nil),
+			"retry_wait_max":             tftypes.NewValue(tftypes.Number, nil),
+			"requests_per_minute":        tftypes.NewValue(tftypes.Number, nil),
+			"expected_organization":      tftypes.NewValue(tftypes.String, nil),
+			"proxy_url":                  tftypes.NewValue(tftypes.String, nil),
+			"ca_cert_file":               tftypes.NewValue(tftypes.String, nil),
+			"ca_cert_pem":                tftypes.NewValue(tftypes.String, nil),
+			"insecure_skip_verify":       tftypes.NewValue(tftypes.Bool, nil),
+			"extra_headers":              tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"managed_marker":             tftypes.NewValue(tftypes.String, nil),
+			"name_prefix":                tftypes.NewValue(tftypes.String, nil),
 		},
 	)
 
@@ -3,7 +3,9 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -32,6 +34,11 @@ type mackerelAlertGroupSettingResource struct {
 	Client *mackerel.Client
 }
 
+type mackerelAlertGroupSettingResourceModel struct {
+	mackerel.AlertGroupSettingModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *mackerelAlertGroupSettingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_alert_group_setting"
 }
@@ -50,12 +57,19 @@ func (r *mackerelAlertGroupSettingResource) Configure(ctx context.Context, req r
 }
 
 func (r *mackerelAlertGroupSettingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data mackerel.AlertGroupSettingModel
+	var data mackerelAlertGroupSettingResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Create, defaultCreateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Create(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create an alert group setting.",
@@ -68,13 +82,24 @@ func (r *mackerelAlertGroupSettingResource) Create(ctx context.Context, req reso
 }
 
 func (r *mackerelAlertGroupSettingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data mackerel.AlertGroupSettingModel
+	var data mackerelAlertGroupSettingResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Read, defaultReadTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Read(ctx, r.Client); err != nil {
+		if mackerel.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read an alert group setting.",
 			err.Error(),
@@ -86,12 +111,19 @@ func (r *mackerelAlertGroupSettingResource) Read(ctx context.Context, req resour
 }
 
 func (r *mackerelAlertGroupSettingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data mackerel.AlertGroupSettingModel
+	var data mackerelAlertGroupSettingResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Update, defaultUpdateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Update(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to update an alert group setting.",
@@ -104,12 +136,19 @@ func (r *mackerelAlertGroupSettingResource) Update(ctx context.Context, req reso
 }
 
 func (r *mackerelAlertGroupSettingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data mackerel.AlertGroupSettingModel
+	var data mackerelAlertGroupSettingResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Delete, defaultDeleteTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Delete(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete an alert group setting.",
@@ -127,6 +166,7 @@ const (
 	schemaAlertGroupSettingIDDesc                   = "The ID of the alert group setting."
 	schemaAlertGroupSettingNameDesc                 = "The name of the alert group setting."
 	schemaAlertGroupSettingMemoDesc                 = "The notes related to the alert group setting."
+	schemaAlertGroupSettingLabelsDesc               = "The labels of the alert group setting. They are stored in the footer of the memo."
 	schemaAlertGroupSettingServiceScopesDesc        = "The set of the target service names."
 	schemaAlertGroupSettingRoleScopesDesc           = "The set of the target role IDs."
 	schemaAlertGroupSettingMonitorScopesDesc        = "The set of the target monitor IDs."
@@ -153,6 +193,14 @@ var schemaAlertGroupSettingResource = schema.Schema{
 			Computed:    true,
 			Default:     stringdefault.StaticString(""),
 		},
+		"labels": schema.MapAttribute{
+			Description: schemaAlertGroupSettingLabelsDesc,
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators: []validator.Map{
+				mapvalidator.SizeAtLeast(1),
+			},
+		},
 		"service_scopes": schema.SetAttribute{
 			Description: schemaAlertGroupSettingServiceScopesDesc,
 			ElementType: types.StringType,
@@ -187,4 +235,7 @@ var schemaAlertGroupSettingResource = schema.Schema{
 			},
 		},
 	},
+	Blocks: map[string]schema.Block{
+		"timeouts": schemaTimeoutsBlock(),
+	},
 }
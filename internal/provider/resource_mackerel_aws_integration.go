@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -32,6 +33,11 @@ type mackerelAWSIntegrationResource struct {
 	Client *mackerel.Client
 }
 
+type mackerelAWSIntegrationResourceModel struct {
+	mackerel.AWSIntegrationModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (*mackerelAWSIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_aws_integration"
 }
@@ -50,12 +56,19 @@ func (r *mackerelAWSIntegrationResource) Configure(ctx context.Context, req reso
 }
 
 func (r *mackerelAWSIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data mackerel.AWSIntegrationModel
+	var data mackerelAWSIntegrationResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Create, defaultCreateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Create(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create aws integration settings",
@@ -68,13 +81,24 @@ func (r *mackerelAWSIntegrationResource) Create(ctx context.Context, req resourc
 }
 
 func (r *mackerelAWSIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data mackerel.AWSIntegrationModel
+	var data mackerelAWSIntegrationResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Read, defaultReadTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Read(ctx, r.Client); err != nil {
+		if mackerel.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read aws integration settings",
 			err.Error(),
@@ -86,12 +110,19 @@ func (r *mackerelAWSIntegrationResource) Read(ctx context.Context, req resource.
 }
 
 func (r *mackerelAWSIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data mackerel.AWSIntegrationModel
+	var data mackerelAWSIntegrationResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Update, defaultUpdateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Update(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to update aws integration settings",
@@ -104,12 +135,19 @@ func (r *mackerelAWSIntegrationResource) Update(ctx context.Context, req resourc
 }
 
 func (r *mackerelAWSIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data mackerel.AWSIntegrationModel
+	var data mackerelAWSIntegrationResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Delete, defaultDeleteTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Delete(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete aws integration settings",
@@ -256,6 +294,7 @@ func schemaAWSIntegrationResource() schema.Schema {
 		}
 		services[name] = block
 	}
+	services["timeouts"] = schemaTimeoutsBlock()
 
 	schema := schema.Schema{
 		Description: "This resource allows creating and management of the AWS integration.",
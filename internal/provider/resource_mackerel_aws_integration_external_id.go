@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/mackerel"
+)
+
+var (
+	_ resource.Resource                = (*mackerelAWSIntegrationExternalIDResource)(nil)
+	_ resource.ResourceWithConfigure   = (*mackerelAWSIntegrationExternalIDResource)(nil)
+	_ resource.ResourceWithImportState = (*mackerelAWSIntegrationExternalIDResource)(nil)
+)
+
+func NewMackerelAWSIntegrationExternalIDResource() resource.Resource {
+	return &mackerelAWSIntegrationExternalIDResource{}
+}
+
+type mackerelAWSIntegrationExternalIDResource struct {
+	Client *mackerel.Client
+}
+
+type mackerelAWSIntegrationExternalIDResourceModel struct {
+	mackerel.AWSIntegrationExternalIDModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *mackerelAWSIntegrationExternalIDResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_aws_integration_external_id"
+}
+
+func (r *mackerelAWSIntegrationExternalIDResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This resource generates an external ID for AWS integrations.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The hash of the external ID, which does not reveal the external ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(), // immutable
+				},
+			},
+			"external_id": schema.StringAttribute{
+				Description: "The generated external ID, to be set to the trust policy of the IAM role and `external_id` of `mackerel_aws_integration`.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(), // immutable
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": schemaTimeoutsBlock(),
+		},
+	}
+}
+
+func (r *mackerelAWSIntegrationExternalIDResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := retrieveClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	r.Client = client
+}
+
+func (r *mackerelAWSIntegrationExternalIDResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data mackerelAWSIntegrationExternalIDResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Create, defaultCreateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.Create(ctx, r.Client); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to generate an external ID for AWS integrations",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *mackerelAWSIntegrationExternalIDResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data mackerelAWSIntegrationExternalIDResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Mackerel API has no way to read external IDs, so the state is kept as it is.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *mackerelAWSIntegrationExternalIDResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data mackerelAWSIntegrationExternalIDResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only timeouts can be changed, so there is nothing to update in Mackerel.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *mackerelAWSIntegrationExternalIDResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+	// Mackerel API has no way to delete external IDs, so it is only removed from the state.
+}
+
+func (r *mackerelAWSIntegrationExternalIDResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.Set(ctx, &mackerelAWSIntegrationExternalIDResourceModel{
+		AWSIntegrationExternalIDModel: mackerel.ImportAWSIntegrationExternalID(req.ID),
+		Timeouts:                      nullTimeouts(),
+	})...)
+}
@@ -0,0 +1,67 @@
+package provider_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/provider"
+)
+
+func Test_MackerelAWSIntegrationExternalIDResource_schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	req := fwresource.SchemaRequest{}
+	resp := fwresource.SchemaResponse{}
+	provider.NewMackerelAWSIntegrationExternalIDResource().Schema(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("schema diagnostics: %+v", resp.Diagnostics)
+	}
+
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema validation diagnostics: %+v", diags)
+	}
+}
+
+func TestAccMackerelAWSIntegrationExternalID(t *testing.T) {
+	resourceName := "mackerel_aws_integration_external_id.foo"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { preCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: `resource "mackerel_aws_integration_external_id" "foo" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "external_id"),
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^[0-9a-f]{16}$`)),
+				),
+			},
+			// Test: Keep the generated ID
+			{
+				Config:   `resource "mackerel_aws_integration_external_id" "foo" {}`,
+				PlanOnly: true,
+			},
+			// Test: Import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported by the external ID, not by the ID of the resource.
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("external ID not found from resources: %s", resourceName)
+					}
+					return rs.Primary.Attributes["external_id"], nil
+				},
+			},
+		},
+	})
+}
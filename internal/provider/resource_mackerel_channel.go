@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
@@ -37,6 +38,11 @@ type mackerelChannelResource struct {
 	Client *mackerel.Client
 }
 
+type mackerelChannelResourceModel struct {
+	mackerel.ChannelModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *mackerelChannelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_channel"
 }
@@ -60,12 +66,19 @@ func (r *mackerelChannelResource) Configure(ctx context.Context, req resource.Co
 }
 
 func (r *mackerelChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data mackerel.ChannelModel
+	var data mackerelChannelResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Create, defaultCreateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Create(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create a channel",
@@ -78,13 +91,24 @@ func (r *mackerelChannelResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *mackerelChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data mackerel.ChannelModel
+	var data mackerelChannelResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Read, defaultReadTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Read(ctx, r.Client); err != nil {
+		if mackerel.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read a channel",
 			err.Error(),
@@ -96,12 +120,19 @@ func (r *mackerelChannelResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *mackerelChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data mackerel.ChannelModel
+	var data mackerelChannelResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Update, defaultUpdateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := data.Update(ctx, r.Client)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -115,12 +146,19 @@ func (r *mackerelChannelResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *mackerelChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data mackerel.ChannelModel
+	var data mackerelChannelResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Delete, defaultDeleteTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Delete(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete a channel",
@@ -305,6 +343,7 @@ func schemaChannelResource() (schema.Schema, []resource.ConfigValidator) {
 					},
 				},
 			},
+			"timeouts": schemaTimeoutsBlock(),
 		},
 	}
 	validators := []resource.ConfigValidator{
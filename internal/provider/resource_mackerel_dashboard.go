@@ -3,7 +3,9 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -31,6 +33,11 @@ type mackerelDashboardResource struct {
 	Client *mackerel.Client
 }
 
+type mackerelDashboardResourceModel struct {
+	mackerel.DashboardModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *mackerelDashboardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_dashboard"
 }
@@ -49,12 +56,19 @@ func (r *mackerelDashboardResource) Configure(ctx context.Context, req resource.
 }
 
 func (r *mackerelDashboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data mackerel.DashboardModel
+	var data mackerelDashboardResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Create, defaultCreateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Create(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create a dashboard",
@@ -67,13 +81,24 @@ func (r *mackerelDashboardResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *mackerelDashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data mackerel.DashboardModel
+	var data mackerelDashboardResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Read, defaultReadTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Read(ctx, r.Client); err != nil {
+		if mackerel.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read a dashboard",
 			err.Error(),
@@ -85,12 +110,19 @@ func (r *mackerelDashboardResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *mackerelDashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data mackerel.DashboardModel
+	var data mackerelDashboardResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Update, defaultUpdateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Update(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to update a dashboard",
@@ -103,12 +135,19 @@ func (r *mackerelDashboardResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *mackerelDashboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data mackerel.DashboardModel
+	var data mackerelDashboardResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Delete, defaultDeleteTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Delete(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete a dashboard",
@@ -126,6 +165,7 @@ const (
 	schemaDashboardIDDesc        = "The ID of the dashboard."
 	schemaDashboardTitleDesc     = "The name of the dashboard."
 	schemaDashboardMemoDesc      = "The notes regarding the dashboard."
+	schemaDashboardLabelsDesc    = "The labels of the dashboard. They are stored in the footer of the memo."
 	schemaDashboardURLPathDesc   = "The URL path for the dashboard."
 	schemaDashboardCreatedAtDesc = "The time (in epoch seconds) at the dashboard created."
 	schemaDashboardUpdatedAtDesc = "The time (in epoch seconds) at the dashboard last updated."
@@ -152,6 +192,14 @@ func schemaDashboardResource() schema.Schema {
 				Computed:    true,
 				Default:     stringdefault.StaticString(""),
 			},
+			"labels": schema.MapAttribute{
+				Description: schemaDashboardLabelsDesc,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
 			"url_path": schema.StringAttribute{
 				Description: schemaDashboardURLPathDesc,
 				Optional:    true,
@@ -175,6 +223,7 @@ func schemaDashboardResource() schema.Schema {
 			"value":        schemaDashboardResource_value,
 			"markdown":     schemaDashboardResource_markdown,
 			"alert_status": schemaDashboardResource_alertStatus,
+			"timeouts":     schemaTimeoutsBlock(),
 		},
 	}
 	return s
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -26,6 +27,11 @@ type mackerelDefaultNotificationGroupResource struct {
 	Client *mackerel.Client
 }
 
+type mackerelDefaultNotificationGroupResourceModel struct {
+	mackerel.DefaultNotificationGroupModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *mackerelDefaultNotificationGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_default_notification_group"
 }
@@ -61,6 +67,9 @@ func (r *mackerelDefaultNotificationGroupResource) Schema(_ context.Context, _ r
 				Required:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": schemaTimeoutsBlock(),
+		},
 	}
 }
 
@@ -74,12 +83,19 @@ func (r *mackerelDefaultNotificationGroupResource) Configure(ctx context.Context
 }
 
 func (r *mackerelDefaultNotificationGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data mackerel.DefaultNotificationGroupModel
+	var data mackerelDefaultNotificationGroupResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Create, defaultCreateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Create(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to update default notification group",
@@ -92,12 +108,19 @@ func (r *mackerelDefaultNotificationGroupResource) Create(ctx context.Context, r
 }
 
 func (r *mackerelDefaultNotificationGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data mackerel.DefaultNotificationGroupModel
+	var data mackerelDefaultNotificationGroupResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Read, defaultReadTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Read(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to read default notification group",
@@ -110,12 +133,19 @@ func (r *mackerelDefaultNotificationGroupResource) Read(ctx context.Context, req
 }
 
 func (r *mackerelDefaultNotificationGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data mackerel.DefaultNotificationGroupModel
+	var data mackerelDefaultNotificationGroupResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Update, defaultUpdateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Update(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to update default notification group",
@@ -128,12 +158,19 @@ func (r *mackerelDefaultNotificationGroupResource) Update(ctx context.Context, r
 }
 
 func (r *mackerelDefaultNotificationGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data mackerel.DefaultNotificationGroupModel
+	var data mackerelDefaultNotificationGroupResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Delete, defaultDeleteTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Delete(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to remove default notification group from state",
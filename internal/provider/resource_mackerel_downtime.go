@@ -3,7 +3,9 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -33,6 +35,11 @@ type mackerelDowntimeResource struct {
 	Client *mackerel.Client
 }
 
+type mackerelDowntimeResourceModel struct {
+	mackerel.DowntimeModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *mackerelDowntimeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_downtime"
 }
@@ -51,12 +58,19 @@ func (r *mackerelDowntimeResource) Configure(ctx context.Context, req resource.C
 }
 
 func (r *mackerelDowntimeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data mackerel.DowntimeModel
+	var data mackerelDowntimeResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Create, defaultCreateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Create(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create a downtime",
@@ -69,13 +83,24 @@ func (r *mackerelDowntimeResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *mackerelDowntimeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data mackerel.DowntimeModel
+	var data mackerelDowntimeResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Read, defaultReadTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Read(ctx, r.Client); err != nil {
+		if mackerel.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read a downtime",
 			err.Error(),
@@ -87,12 +112,19 @@ func (r *mackerelDowntimeResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *mackerelDowntimeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data mackerel.DowntimeModel
+	var data mackerelDowntimeResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Update, defaultUpdateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Update(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to update a downtime",
@@ -105,12 +137,19 @@ func (r *mackerelDowntimeResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *mackerelDowntimeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data mackerel.DowntimeModel
+	var data mackerelDowntimeResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Delete, defaultDeleteTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Delete(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete a downtime",
@@ -128,6 +167,7 @@ const (
 	schemaDowntimeIDDesc              = "The id of the downtime."
 	schemaDowntimeNameDesc            = "The name of the downtime."
 	schemaDowntimeMemoDesc            = "The notes for the downtime."
+	schemaDowntimeLabelsDesc          = "The labels of the downtime. They are stored in the footer of the memo."
 	schemaDowntimeStartDesc           = "The starting time (in epoch seconds) of the downtime."
 	schemaDowntimeDurationDesc        = "The duration (in minutes) of the downtime."
 	schemaDowntimeRecurrenceDesc      = "The configuration for repeating occurrences."
@@ -167,6 +207,14 @@ func schemaDowntimeResource() schema.Schema {
 				Computed:    true,
 				Default:     stringdefault.StaticString(""),
 			},
+			"labels": schema.MapAttribute{
+				Description: schemaDowntimeLabelsDesc,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
 			"start": schema.Int64Attribute{
 				Description: schemaDowntimeStartDesc,
 				Required:    true,
@@ -268,6 +316,7 @@ func schemaDowntimeResource() schema.Schema {
 					},
 				},
 			},
+			"timeouts": schemaTimeoutsBlock(),
 		},
 	}
 	return s
@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/mackerel"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/typeutil"
+)
+
+var (
+	_ resource.Resource                   = (*mackerelGraphAnnotationResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*mackerelGraphAnnotationResource)(nil)
+	_ resource.ResourceWithConfigure      = (*mackerelGraphAnnotationResource)(nil)
+	_ resource.ResourceWithImportState    = (*mackerelGraphAnnotationResource)(nil)
+)
+
+func NewMackerelGraphAnnotationResource() resource.Resource {
+	return &mackerelGraphAnnotationResource{}
+}
+
+type mackerelGraphAnnotationResource struct {
+	Client *mackerel.Client
+}
+
+type mackerelGraphAnnotationResourceModel struct {
+	mackerel.GraphAnnotationModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *mackerelGraphAnnotationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_annotation"
+}
+
+func (r *mackerelGraphAnnotationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This resource allows creating and management of a graph annotation.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: schemaGraphAnnotationIDDesc,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(), // immutable
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: schemaGraphAnnotationTitleDesc,
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: schemaGraphAnnotationDescriptionDesc,
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"from": schema.StringAttribute{
+				Description: schemaGraphAnnotationFromDesc,
+				Required:    true,
+				CustomType:  typeutil.TimeStringType{},
+			},
+			"to": schema.StringAttribute{
+				Description: schemaGraphAnnotationToDesc,
+				Required:    true,
+				CustomType:  typeutil.TimeStringType{},
+			},
+			"service": schema.StringAttribute{
+				Description: schemaGraphAnnotationServiceDesc,
+				Required:    true,
+				Validators: []validator.String{
+					mackerel.ServiceNameValidator(),
+				},
+			},
+			"roles": schema.SetAttribute{
+				Description: schemaGraphAnnotationRolesDesc,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, nil)),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(mackerel.RoleNameValidator()),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": schemaTimeoutsBlock(),
+		},
+	}
+}
+
+const (
+	schemaGraphAnnotationIDDesc          = "The ID of the graph annotation."
+	schemaGraphAnnotationTitleDesc       = "The title of the graph annotation."
+	schemaGraphAnnotationDescriptionDesc = "The details of the graph annotation."
+	schemaGraphAnnotationFromDesc        = "The starting time of the graph annotation, in RFC3339 (e.g. `2006-01-02T15:04:05+09:00`) or in epoch seconds."
+	schemaGraphAnnotationToDesc          = "The ending time of the graph annotation, in RFC3339 (e.g. `2006-01-02T15:04:05+09:00`) or in epoch seconds."
+	schemaGraphAnnotationServiceDesc     = "The name of the service which the graph annotation is shown in."
+	schemaGraphAnnotationRolesDesc       = "The set of roles in the service which the graph annotation is shown in. If empty, it is shown in all graphs of the service."
+)
+
+func (r *mackerelGraphAnnotationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data mackerel.GraphAnnotationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.From.IsUnknown() || data.From.IsNull() || data.To.IsUnknown() || data.To.IsNull() {
+		return
+	}
+	if data.From.ValueEpoch() > data.To.ValueEpoch() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("to"),
+			"Invalid Attribute Value",
+			"`to` must not be earlier than `from`.",
+		)
+	}
+}
+
+func (r *mackerelGraphAnnotationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := retrieveClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	r.Client = client
+}
+
+func (r *mackerelGraphAnnotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data mackerelGraphAnnotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Create, defaultCreateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.Create(ctx, r.Client); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create Graph Annotation",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *mackerelGraphAnnotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data mackerelGraphAnnotationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Read, defaultReadTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.Read(ctx, r.Client); err != nil {
+		if mackerel.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read Graph Annotation",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *mackerelGraphAnnotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data mackerelGraphAnnotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Update, defaultUpdateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.Update(ctx, r.Client); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update Graph Annotation",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *mackerelGraphAnnotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data mackerelGraphAnnotationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Delete, defaultDeleteTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.Delete(ctx, r.Client); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete Graph Annotation",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *mackerelGraphAnnotationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	data, err := mackerel.ImportGraphAnnotation(ctx, r.Client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to import Graph Annotation",
+			err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &mackerelGraphAnnotationResourceModel{
+		GraphAnnotationModel: data,
+		Timeouts:             nullTimeouts(),
+	})...)
+}
@@ -0,0 +1,110 @@
+package provider_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/provider"
+)
+
+func Test_MackerelGraphAnnotationResource_schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	req := fwresource.SchemaRequest{}
+	resp := fwresource.SchemaResponse{}
+	provider.NewMackerelGraphAnnotationResource().Schema(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("schema diagnostics: %+v", resp.Diagnostics)
+	}
+
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema validation diagnostics: %+v", diags)
+	}
+}
+
+func TestAccMackerelGraphAnnotation(t *testing.T) {
+	resourceName := "mackerel_graph_annotation.foo"
+	rand := acctest.RandString(5)
+	name := fmt.Sprintf("tf-%s", rand)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { preCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccMackerelGraphAnnotationConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "title", "deploy"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "from", "2023-11-14T22:13:20Z"),
+					resource.TestCheckResourceAttr(resourceName, "to", "1700000060"),
+					resource.TestCheckResourceAttr(resourceName, "service", name),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "0"),
+				),
+			},
+			// Test: Update
+			{
+				Config: testAccMackerelGraphAnnotationConfigUpdated(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "title", "migration"),
+					resource.TestCheckResourceAttr(resourceName, "description", "add an index"),
+					resource.TestCheckResourceAttr(resourceName, "to", "1700003600"),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "roles.0", "db"),
+				),
+			},
+			// Test: Import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Times are imported in epoch seconds.
+				ImportStateVerifyIgnore: []string{"from"},
+			},
+		},
+	})
+}
+
+func testAccMackerelGraphAnnotationConfig(name string) string {
+	return fmt.Sprintf(`
+resource "mackerel_service" "foo" {
+  name = "%s"
+}
+
+resource "mackerel_graph_annotation" "foo" {
+  title = "deploy"
+  from = "2023-11-14T22:13:20Z"
+  to = "1700000060"
+  service = mackerel_service.foo.name
+}
+`, name)
+}
+
+func testAccMackerelGraphAnnotationConfigUpdated(name string) string {
+	return fmt.Sprintf(`
+resource "mackerel_service" "foo" {
+  name = "%s"
+}
+
+resource "mackerel_role" "db" {
+  service = mackerel_service.foo.name
+  name = "db"
+}
+
+resource "mackerel_graph_annotation" "foo" {
+  title = "migration"
+  description = "add an index"
+  from = "2023-11-14T22:13:20Z"
+  to = "1700003600"
+  service = mackerel_service.foo.name
+  roles = [mackerel_role.db.name]
+}
+`, name)
+}
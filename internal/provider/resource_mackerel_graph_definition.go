@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/mackerel"
+)
+
+var (
+	_ resource.Resource                = (*mackerelGraphDefinitionResource)(nil)
+	_ resource.ResourceWithConfigure   = (*mackerelGraphDefinitionResource)(nil)
+	_ resource.ResourceWithImportState = (*mackerelGraphDefinitionResource)(nil)
+)
+
+func NewMackerelGraphDefinitionResource() resource.Resource {
+	return &mackerelGraphDefinitionResource{}
+}
+
+type mackerelGraphDefinitionResource struct {
+	Client *mackerel.Client
+}
+
+type mackerelGraphDefinitionResourceModel struct {
+	mackerel.GraphDefinitionModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *mackerelGraphDefinitionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_definition"
+}
+
+func (r *mackerelGraphDefinitionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This resource allows creating and management of a graph definition of custom metrics.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The name of the graph.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(), // immutable
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: schemaGraphDefinitionNameDesc,
+				Required:    true,
+				Validators: []validator.String{
+					mackerel.GraphDefinitionNameValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(), // force new
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Description: schemaGraphDefinitionDisplayNameDesc,
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"unit": schema.StringAttribute{
+				Description: schemaGraphDefinitionUnitDesc,
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("float"),
+				Validators: []validator.String{
+					mackerel.GraphDefinitionUnitValidator(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"metric": schema.ListNestedBlock{
+				Description: schemaGraphDefinitionMetricDesc,
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: schemaGraphDefinitionMetricNameDesc,
+							Required:    true,
+							Validators: []validator.String{
+								mackerel.GraphDefinitionNameValidator(),
+							},
+						},
+						"display_name": schema.StringAttribute{
+							Description: schemaGraphDefinitionMetricDisplayNameDesc,
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(""),
+						},
+						"is_stacked": schema.BoolAttribute{
+							Description: schemaGraphDefinitionMetricIsStackedDesc,
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"timeouts": schemaTimeoutsBlock(),
+		},
+	}
+}
+
+const (
+	schemaGraphDefinitionNameDesc              = "The name of the graph, which starts with `custom.`."
+	schemaGraphDefinitionDisplayNameDesc       = "The name of the graph shown in Mackerel."
+	schemaGraphDefinitionUnitDesc              = "The unit of the graph. Valid values are `float`, `integer`, `percentage`, `seconds`, `milliseconds`, `bytes`, `bytes/sec`, `bits/sec` and `iops`. Defaults to `float`."
+	schemaGraphDefinitionMetricDesc            = "The metrics of the graph."
+	schemaGraphDefinitionMetricNameDesc        = "The name of the metric, which starts with the name of the graph. Wildcards `*` and `#` are allowed."
+	schemaGraphDefinitionMetricDisplayNameDesc = "The name of the metric shown in Mackerel."
+	schemaGraphDefinitionMetricIsStackedDesc   = "Whether the metric is stacked on the graph."
+)
+
+func (r *mackerelGraphDefinitionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := retrieveClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	r.Client = client
+}
+
+func (r *mackerelGraphDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data mackerelGraphDefinitionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Create, defaultCreateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.Create(ctx, r.Client); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create Graph Definition",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *mackerelGraphDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data mackerelGraphDefinitionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Mackerel API has no way to read graph definitions, so the state is kept as it is.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *mackerelGraphDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data mackerelGraphDefinitionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Update, defaultUpdateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.Update(ctx, r.Client); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update Graph Definition",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *mackerelGraphDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data mackerelGraphDefinitionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Delete, defaultDeleteTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.Delete(ctx, r.Client); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete Graph Definition",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *mackerelGraphDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	data, err := mackerel.ImportGraphDefinition(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid ID",
+			err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &mackerelGraphDefinitionResourceModel{
+		GraphDefinitionModel: data,
+		Timeouts:             nullTimeouts(),
+	})...)
+}
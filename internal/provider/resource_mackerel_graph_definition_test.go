@@ -0,0 +1,110 @@
+package provider_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/provider"
+)
+
+func Test_MackerelGraphDefinitionResource_schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	req := fwresource.SchemaRequest{}
+	resp := fwresource.SchemaResponse{}
+	provider.NewMackerelGraphDefinitionResource().Schema(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("schema diagnostics: %+v", resp.Diagnostics)
+	}
+
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema validation diagnostics: %+v", diags)
+	}
+}
+
+func TestAccMackerelGraphDefinition(t *testing.T) {
+	resourceName := "mackerel_graph_definition.foo"
+	rand := acctest.RandString(5)
+	name := fmt.Sprintf("custom.tf-%s", rand)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { preCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccMackerelGraphDefinitionConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", name),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "display_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "unit", "float"),
+					resource.TestCheckResourceAttr(resourceName, "metric.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metric.0.name", name+".waiting"),
+					resource.TestCheckResourceAttr(resourceName, "metric.0.display_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "metric.0.is_stacked", "false"),
+				),
+			},
+			// Test: Update
+			{
+				Config: testAccMackerelGraphDefinitionConfigUpdated(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", name),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Queue"),
+					resource.TestCheckResourceAttr(resourceName, "unit", "integer"),
+					resource.TestCheckResourceAttr(resourceName, "metric.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "metric.0.display_name", "Waiting"),
+					resource.TestCheckResourceAttr(resourceName, "metric.0.is_stacked", "true"),
+					resource.TestCheckResourceAttr(resourceName, "metric.1.name", name+".running"),
+				),
+			},
+			// Test: Import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Only the name can be imported since graph definitions cannot be read.
+				ImportStateVerifyIgnore: []string{"display_name", "unit", "metric"},
+			},
+		},
+	})
+}
+
+func testAccMackerelGraphDefinitionConfig(name string) string {
+	return fmt.Sprintf(`
+resource "mackerel_graph_definition" "foo" {
+  name = "%[1]s"
+
+  metric {
+    name = "%[1]s.waiting"
+  }
+}
+`, name)
+}
+
+func testAccMackerelGraphDefinitionConfigUpdated(name string) string {
+	return fmt.Sprintf(`
+resource "mackerel_graph_definition" "foo" {
+  name = "%[1]s"
+  display_name = "Queue"
+  unit = "integer"
+
+  metric {
+    name = "%[1]s.waiting"
+    display_name = "Waiting"
+    is_stacked = true
+  }
+
+  metric {
+    name = "%[1]s.running"
+    display_name = "Running"
+    is_stacked = true
+  }
+}
+`, name)
+}
@@ -0,0 +1,122 @@
+package provider_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/provider"
+)
+
+func Test_MackerelHostMetadataResource_schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	req := fwresource.SchemaRequest{}
+	resp := fwresource.SchemaResponse{}
+	provider.NewMackerelHostMetadataResource().Schema(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("schema diagnostics: %+v", resp.Diagnostics)
+	}
+
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema validation diagnostics: %+v", diags)
+	}
+}
+
+func TestAccMackerelHostMetadata(t *testing.T) {
+	resourceName := "mackerel_host_metadata.foo"
+	rand := acctest.RandString(5)
+	rHostName := fmt.Sprintf("tf-host-%s", rand)
+	rNamespace := fmt.Sprintf("tf-namespace-%s", rand)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { preCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMackerelHostMetadataDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccMackerelHostMetadataConfig(rHostName, rNamespace, "sre"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMackerelHostMetadataExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "host_id", "mackerel_host.foo", "id"),
+					resource.TestCheckResourceAttr(resourceName, "namespace", rNamespace),
+					resource.TestCheckResourceAttr(resourceName, "metadata_json", `{"owner":"sre","rack":"a-1"}`),
+				),
+			},
+			// Test: Update
+			{
+				Config: testAccMackerelHostMetadataConfig(rHostName, rNamespace, "dev"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMackerelHostMetadataExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "host_id", "mackerel_host.foo", "id"),
+					resource.TestCheckResourceAttr(resourceName, "namespace", rNamespace),
+					resource.TestCheckResourceAttr(resourceName, "metadata_json", `{"owner":"dev","rack":"a-1"}`),
+				),
+			},
+			// Test: Import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMackerelHostMetadataDestroy(s *terraform.State) error {
+	client := mackerelClient()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "mackerel_host_metadata" {
+			continue
+		}
+
+		hostID := r.Primary.Attributes["host_id"]
+		namespace := r.Primary.Attributes["namespace"]
+		if _, err := client.GetHostMetaData(hostID, namespace); err == nil {
+			return fmt.Errorf("host metadata still exists: %s/%s", hostID, namespace)
+		}
+	}
+	return nil
+}
+
+func testAccCheckMackerelHostMetadataExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("host metadata not found resources: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no host metadata ID is set")
+		}
+
+		client := mackerelClient()
+		_, err := client.GetHostMetaData(rs.Primary.Attributes["host_id"], rs.Primary.Attributes["namespace"])
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccMackerelHostMetadataConfig(hostName, namespace, owner string) string {
+	return fmt.Sprintf(`
+resource "mackerel_host" "foo" {
+  name = "%s"
+}
+
+resource "mackerel_host_metadata" "foo" {
+  host_id = mackerel_host.foo.id
+  namespace = "%s"
+  metadata_json = jsonencode({
+    owner = "%s"
+    rack = "a-1"
+  })
+}
+`, hostName, namespace, owner)
+}
@@ -0,0 +1,139 @@
+package provider_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/provider"
+)
+
+func Test_MackerelHostRoleAttachmentResource_schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	req := fwresource.SchemaRequest{}
+	resp := fwresource.SchemaResponse{}
+	provider.NewMackerelHostRoleAttachmentResource().Schema(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("schema diagnostics: %+v", resp.Diagnostics)
+	}
+
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema validation diagnostics: %+v", diags)
+	}
+}
+
+func TestAccMackerelHostRoleAttachment(t *testing.T) {
+	resourceName := "mackerel_host_role_attachment.foo"
+	rand := acctest.RandString(5)
+	rName := fmt.Sprintf("tf-%s", rand)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { preCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMackerelHostRoleAttachmentDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccMackerelHostRoleAttachmentConfig(rName, "batch"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMackerelHostRoleAttachmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "host_id", "mackerel_host.foo", "id"),
+					resource.TestCheckResourceAttr(resourceName, "role_fullname", rName+":batch"),
+				),
+			},
+			// Test: Replace
+			{
+				Config: testAccMackerelHostRoleAttachmentConfig(rName, "worker"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMackerelHostRoleAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "role_fullname", rName+":worker"),
+				),
+			},
+			// Test: Import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMackerelHostRoleAttachmentDestroy(s *terraform.State) error {
+	client := mackerelClient()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "mackerel_host_role_attachment" {
+			continue
+		}
+
+		host, err := client.FindHost(r.Primary.Attributes["host_id"])
+		if err != nil || host.IsRetired {
+			continue
+		}
+		if slices.Contains(host.GetRoleFullnames(), r.Primary.Attributes["role_fullname"]) {
+			return fmt.Errorf("role is still attached: %s", r.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckMackerelHostRoleAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("host role attachment not found from resources: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no host role attachment ID is set")
+		}
+
+		client := mackerelClient()
+		host, err := client.FindHost(rs.Primary.Attributes["host_id"])
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(host.GetRoleFullnames(), rs.Primary.Attributes["role_fullname"]) {
+			return fmt.Errorf("role is not attached: %s", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccMackerelHostRoleAttachmentConfig(name, role string) string {
+	return fmt.Sprintf(`
+resource "mackerel_service" "foo" {
+  name = "%[1]s"
+}
+
+resource "mackerel_role" "batch" {
+  service = mackerel_service.foo.name
+  name = "batch"
+}
+
+resource "mackerel_role" "worker" {
+  service = mackerel_service.foo.name
+  name = "worker"
+}
+
+resource "mackerel_host" "foo" {
+  name = "%[1]s"
+
+  # The roles attached by mackerel_host_role_attachment are not managed here.
+  lifecycle {
+    ignore_changes = [role_fullnames]
+  }
+}
+
+resource "mackerel_host_role_attachment" "foo" {
+  host_id = mackerel_host.foo.id
+  role_fullname = "${mackerel_service.foo.name}:${mackerel_role.%[2]s.name}"
+}
+`, name, role)
+}
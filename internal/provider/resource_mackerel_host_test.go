@@ -0,0 +1,140 @@
+package provider_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/provider"
+)
+
+func Test_MackerelHostResource_schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	req := fwresource.SchemaRequest{}
+	resp := fwresource.SchemaResponse{}
+	provider.NewMackerelHostResource().Schema(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("schema diagnostica: %+v", resp.Diagnostics)
+	}
+
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema validation diagnostica: %+v", diags)
+	}
+}
+
+func TestAccMackerelHost(t *testing.T) {
+	resourceName := "mackerel_host.foo"
+	rand := acctest.RandString(5)
+	name := fmt.Sprintf("tf-host-%s", rand)
+	nameUpdated := fmt.Sprintf("tf-host-%s-updated", rand)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { preCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMackerelHostDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccMackerelHostConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMackerelHostExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "display_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "memo", ""),
+					resource.TestCheckResourceAttr(resourceName, "role_fullnames.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			// Test: Update
+			{
+				Config: testAccMackerelHostConfigUpdated(rand, nameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMackerelHostExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", nameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Terraform Host"),
+					resource.TestCheckResourceAttr(resourceName, "custom_identifier", nameUpdated+".example.com"),
+					resource.TestCheckResourceAttr(resourceName, "memo", "This host is managed by Terraform."),
+					resource.TestCheckResourceAttr(resourceName, "role_fullnames.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", "maintenance"),
+				),
+			},
+			// Test: Import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMackerelHostDestroy(s *terraform.State) error {
+	client := mackerelClient()
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "mackerel_host" {
+			continue
+		}
+		host, err := client.FindHost(r.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !host.IsRetired {
+			return fmt.Errorf("host is not retired: %s", r.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckMackerelHostExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("host not found from resources: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no host ID is set")
+		}
+
+		client := mackerelClient()
+		if _, err := client.FindHost(rs.Primary.ID); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccMackerelHostConfig(name string) string {
+	return fmt.Sprintf(`
+resource "mackerel_host" "foo" {
+  name = "%s"
+}
+`, name)
+}
+
+func testAccMackerelHostConfigUpdated(rand, name string) string {
+	return fmt.Sprintf(`
+resource "mackerel_service" "foo" {
+  name = "tf-service-%s"
+}
+resource "mackerel_role" "foo" {
+  service = mackerel_service.foo.id
+  name = "tf-role-%s"
+}
+resource "mackerel_host" "foo" {
+  name = "%s"
+  display_name = "Terraform Host"
+  custom_identifier = "%s.example.com"
+  memo = "This host is managed by Terraform."
+  role_fullnames = [mackerel_role.foo.id]
+  status = "maintenance"
+}
+`, rand, rand, name, name)
+}
@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/mackerel"
+)
+
+var (
+	_ resource.Resource                = (*mackerelInvitationResource)(nil)
+	_ resource.ResourceWithConfigure   = (*mackerelInvitationResource)(nil)
+	_ resource.ResourceWithImportState = (*mackerelInvitationResource)(nil)
+)
+
+func NewMackerelInvitationResource() resource.Resource {
+	return &mackerelInvitationResource{}
+}
+
+const (
+	schemaInvitationEmailDesc     = "The email address to send the invitation to."
+	schemaInvitationAuthorityDesc = "The authority given to the user. Valid values are `manager`, `collaborator` and `viewer`. " +
+		"Changing it sends the invitation again while it is pending, and has no effect once it has been accepted."
+	schemaInvitationExpiresAtDesc = "The epoch seconds when the invitation expires."
+	schemaInvitationAcceptedDesc  = "Whether the invitation has been accepted and the user has joined the organization."
+)
+
+// requiresReplaceIfInvitationPending returns a plan modifier that requires replace
+// unless the invitation has been accepted, since sending a new invitation to a member does not change the authority.
+func requiresReplaceIfInvitationPending() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, res *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			var accepted types.Bool
+			res.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("accepted"), &accepted)...)
+			res.RequiresReplace = !accepted.ValueBool()
+		},
+		"Changing the authority sends the invitation again unless it has been accepted.",
+		"Changing the authority sends the invitation again unless it has been accepted.",
+	)
+}
+
+type mackerelInvitationResource struct {
+	Client *mackerel.Client
+}
+
+type mackerelInvitationResourceModel struct {
+	mackerel.InvitationModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *mackerelInvitationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invitation"
+}
+
+func (r *mackerelInvitationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "This resource invites a user to the organization and revokes the invitation while it is pending.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(), // immutable
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: schemaInvitationEmailDesc,
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(), // force new
+				},
+			},
+			"authority": schema.StringAttribute{
+				Description: schemaInvitationAuthorityDesc,
+				Required:    true,
+				Validators: []validator.String{
+					mackerel.InvitationAuthorityValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceIfInvitationPending(),
+				},
+			},
+			"expires_at": schema.Int64Attribute{
+				Description: schemaInvitationExpiresAtDesc,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"accepted": schema.BoolAttribute{
+				Description: schemaInvitationAcceptedDesc,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": schemaTimeoutsBlock(),
+		},
+	}
+}
+
+func (r *mackerelInvitationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, diags := retrieveClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	r.Client = client
+}
+
+func (r *mackerelInvitationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data mackerelInvitationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Create, defaultCreateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.Create(ctx, r.Client); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to send an invitation",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *mackerelInvitationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data mackerelInvitationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Read, defaultReadTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.Read(ctx, r.Client); err != nil {
+		if mackerel.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read an invitation",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *mackerelInvitationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data mackerelInvitationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Other attributes than timeouts and the authority of accepted invitations require replacement,
+	// so there is nothing to update in Mackerel.
+	var state mackerelInvitationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.Accepted.ValueBool() && !data.Authority.Equal(state.Authority) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("authority"),
+			"Authority of Accepted Invitation Not Changed",
+			fmt.Sprintf("The invitation for %s has been accepted, so the authority of the user is not changed by this resource. "+
+				"Change it in Mackerel instead.", data.Email.ValueString()),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *mackerelInvitationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data mackerelInvitationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Delete, defaultDeleteTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.Delete(ctx, r.Client); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to revoke an invitation",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *mackerelInvitationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.Set(ctx, &mackerelInvitationResourceModel{
+		InvitationModel: mackerel.ImportInvitation(req.ID),
+		Timeouts:        nullTimeouts(),
+	})...)
+}
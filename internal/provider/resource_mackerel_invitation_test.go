@@ -0,0 +1,124 @@
+package provider_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/provider"
+	"github.com/mackerelio/mackerel-client-go"
+)
+
+func Test_MackerelInvitationResource_schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	req := fwresource.SchemaRequest{}
+	resp := fwresource.SchemaResponse{}
+	provider.NewMackerelInvitationResource().Schema(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("schema diagnostics: %+v", resp.Diagnostics)
+	}
+
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema validation diagnostics: %+v", diags)
+	}
+}
+
+func TestAccMackerelInvitation(t *testing.T) {
+	resourceName := "mackerel_invitation.foo"
+	rand := acctest.RandString(5)
+	email := fmt.Sprintf("tf-%s@example.com", strings.ToLower(rand))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { preCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMackerelInvitationDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccMackerelInvitationConfig(email, "viewer"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMackerelInvitationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "id", email),
+					resource.TestCheckResourceAttr(resourceName, "email", email),
+					resource.TestCheckResourceAttr(resourceName, "authority", "viewer"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires_at"),
+					resource.TestCheckResourceAttr(resourceName, "accepted", "false"),
+				),
+			},
+			// Test: Replace
+			{
+				Config: testAccMackerelInvitationConfig(email, "collaborator"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMackerelInvitationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authority", "collaborator"),
+				),
+			},
+			// Test: Import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMackerelInvitationDestroy(s *terraform.State) error {
+	client := mackerelClient()
+	invitations, err := client.FindInvitations()
+	if err != nil {
+		return err
+	}
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "mackerel_invitation" {
+			continue
+		}
+		if slices.ContainsFunc(invitations, func(i *mackerel.Invitation) bool {
+			return strings.EqualFold(i.Email, r.Primary.ID)
+		}) {
+			return fmt.Errorf("invitation still exists: %s", r.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckMackerelInvitationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("invitation not found from resources: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no invitation ID is set")
+		}
+
+		client := mackerelClient()
+		invitations, err := client.FindInvitations()
+		if err != nil {
+			return err
+		}
+		if !slices.ContainsFunc(invitations, func(i *mackerel.Invitation) bool {
+			return strings.EqualFold(i.Email, rs.Primary.ID)
+		}) {
+			return fmt.Errorf("invitation not found from mackerel: %s", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccMackerelInvitationConfig(email, authority string) string {
+	return fmt.Sprintf(`
+resource "mackerel_invitation" "foo" {
+  email = "%s"
+  authority = "%s"
+}
+`, email, authority)
+}
@@ -3,9 +3,11 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -41,6 +43,11 @@ type mackerelMonitorResource struct {
 	Client *mackerel.Client
 }
 
+type mackerelMonitorResourceModel struct {
+	mackerel.MonitorModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *mackerelMonitorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_monitor"
 }
@@ -64,12 +71,19 @@ func (r *mackerelMonitorResource) Configure(ctx context.Context, req resource.Co
 }
 
 func (r *mackerelMonitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data mackerel.MonitorModel
+	var data mackerelMonitorResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Create, defaultCreateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Create(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create Monitor",
@@ -82,29 +96,48 @@ func (r *mackerelMonitorResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *mackerelMonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data mackerel.MonitorModel
+	var data mackerelMonitorResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Read, defaultReadTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Read(ctx, r.Client); err != nil {
+		if mackerel.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Unable to read Monitor",
 			err.Error(),
 		)
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *mackerelMonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data mackerel.MonitorModel
+	var data mackerelMonitorResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Update, defaultUpdateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Update(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to update Monitor",
@@ -117,12 +150,19 @@ func (r *mackerelMonitorResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *mackerelMonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data mackerel.MonitorModel
+	var data mackerelMonitorResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Delete, defaultDeleteTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Delete(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete Monitor",
@@ -142,6 +182,7 @@ const (
 	schemaMonitorIDDesc                   = "The ID of the monitor."
 	schemaMonitorNameDesc                 = "The name of the monitor."
 	schemaMonitorMemoDesc                 = "The notes for the monitoring configuration."
+	schemaMonitorLabelsDesc               = "The labels of the monitor. They are stored in the footer of the memo."
 	schemaMonitorIsMuteDesc               = "Whether monitoring is muted or not."
 	schemaMonitorNotificationIntervalDesc = "The time interval (in minutes) for re-sending notifications." +
 		"If this field is empty, notifications will not be re-sent."
@@ -168,6 +209,14 @@ func schemaMonitorResource() (schema.Schema, []resource.ConfigValidator) {
 					Computed:    true,
 					Default:     stringdefault.StaticString(""),
 				},
+				"labels": schema.MapAttribute{
+					Description: schemaMonitorLabelsDesc,
+					ElementType: types.StringType,
+					Optional:    true,
+					Validators: []validator.Map{
+						mapvalidator.SizeAtLeast(1),
+					},
+				},
 				"is_mute": schema.BoolAttribute{
 					Description: schemaMonitorIsMuteDesc,
 					Optional:    true,
@@ -192,6 +241,7 @@ func schemaMonitorResource() (schema.Schema, []resource.ConfigValidator) {
 				"connectivity":      schemaMonitorResourceConnectivityBlock(),
 				"external":          schemaMonitorResourceExternalBlock(),
 				"anomaly_detection": schemaMonitorResourceAnomalyDetectionBlock(),
+				"timeouts":          schemaTimeoutsBlock(),
 			},
 		}, []resource.ConfigValidator{
 			resourcevalidator.ExactlyOneOf(
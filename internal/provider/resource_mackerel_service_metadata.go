@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -30,6 +31,11 @@ type mackerelServiceMetadataResource struct {
 	Client *mackerel.Client
 }
 
+type mackerelServiceMetadataResourceModel struct {
+	mackerel.ServiceMetadataModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *mackerelServiceMetadataResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_service_metadata"
 }
@@ -70,6 +76,9 @@ func (r *mackerelServiceMetadataResource) Schema(_ context.Context, _ resource.S
 				CustomType: jsontypes.NormalizedType{},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": schemaTimeoutsBlock(),
+		},
 	}
 }
 
@@ -93,13 +102,20 @@ func (r *mackerelServiceMetadataResource) Configure(ctx context.Context, req res
 }
 
 func (r *mackerelServiceMetadataResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data mackerel.ServiceMetadataModel
+	var data mackerelServiceMetadataResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(r.createOrUpdate(ctx, &data)...)
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Create, defaultCreateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.createOrUpdate(ctx, &data.ServiceMetadataModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -108,14 +124,25 @@ func (r *mackerelServiceMetadataResource) Create(ctx context.Context, req resour
 }
 
 func (r *mackerelServiceMetadataResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data mackerel.ServiceMetadataModel
+	var data mackerelServiceMetadataResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	remoteData, err := mackerel.ReadServiceMetadata(ctx, r.Client, data)
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Read, defaultReadTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteData, err := mackerel.ReadServiceMetadata(ctx, r.Client, data.ServiceMetadataModel)
 	if err != nil {
+		if mackerel.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to read Service Metadata: %s", data.ID.ValueString()),
 			err.Error(),
@@ -123,17 +150,25 @@ func (r *mackerelServiceMetadataResource) Read(ctx context.Context, req resource
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &remoteData)...)
+	data.ServiceMetadataModel = remoteData
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *mackerelServiceMetadataResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data mackerel.ServiceMetadataModel
+	var data mackerelServiceMetadataResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(r.createOrUpdate(ctx, &data)...)
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Update, defaultUpdateTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.createOrUpdate(ctx, &data.ServiceMetadataModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -142,12 +177,19 @@ func (r *mackerelServiceMetadataResource) Update(ctx context.Context, req resour
 }
 
 func (r *mackerelServiceMetadataResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data mackerel.ServiceMetadataModel
+	var data mackerelServiceMetadataResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel, diags := contextWithTimeout(ctx, data.Timeouts.Delete, defaultDeleteTimeout)
+	defer cancel()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := data.Delete(ctx, r.Client); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete Service Metadata",
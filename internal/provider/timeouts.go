@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	defaultCreateTimeout = 5 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 5 * time.Minute
+	defaultDeleteTimeout = 5 * time.Minute
+)
+
+// The `timeouts` block shared by all resources.
+func schemaTimeoutsBlock() schema.Block {
+	return timeouts.BlockAll(context.Background())
+}
+
+// Returns the null value of the `timeouts` block, which is used when the state is built from scratch (e.g. import).
+func nullTimeouts() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"read":   types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		}),
+	}
+}
+
+type timeoutFunc func(context.Context, time.Duration) (time.Duration, diag.Diagnostics)
+
+// Returns a context which is canceled after the configured timeout of the operation.
+// The returned cancel function must always be called.
+func contextWithTimeout(ctx context.Context, timeout timeoutFunc, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	d, diags := timeout(ctx, defaultTimeout)
+	ctx, cancel := context.WithTimeout(ctx, d)
+	return ctx, cancel, diags
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Test_contextWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		"create": types.StringType,
+		"read":   types.StringType,
+		"update": types.StringType,
+		"delete": types.StringType,
+	}
+
+	cases := map[string]struct {
+		in      timeouts.Value
+		want    time.Duration
+		wantErr bool
+	}{
+		"null": {
+			in:   nullTimeouts(),
+			want: defaultCreateTimeout,
+		},
+		"configured": {
+			in: timeouts.Value{Object: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"create": types.StringValue("30s"),
+				"read":   types.StringNull(),
+				"update": types.StringNull(),
+				"delete": types.StringNull(),
+			})},
+			want: 30 * time.Second,
+		},
+		"invalid": {
+			in: timeouts.Value{Object: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"create": types.StringValue("soon"),
+				"read":   types.StringNull(),
+				"update": types.StringNull(),
+				"delete": types.StringNull(),
+			})},
+			want:    defaultCreateTimeout,
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			start := time.Now()
+			ctx, cancel, diags := contextWithTimeout(context.Background(), tt.in.Create, defaultCreateTimeout)
+			defer cancel()
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("unexpected diagnostics: %+v", diags)
+			}
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				t.Fatal("expected deadline, but got none")
+			}
+			if got := deadline.Sub(start); got < tt.want || got > tt.want+time.Second {
+				t.Errorf("expected timeout %s, but got %s", tt.want, got)
+			}
+		})
+	}
+}
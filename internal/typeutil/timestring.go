@@ -0,0 +1,191 @@
+package typeutil
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TimeString is a point in time which is written in RFC3339 (e.g. `2006-01-02T15:04:05+09:00`) or in epoch seconds.
+// Values which represent the same point in time are semantically equal.
+type (
+	TimeStringType struct {
+		basetypes.StringType
+	}
+	TimeString struct {
+		basetypes.StringValue
+	}
+)
+
+var (
+	_ basetypes.StringTypable = (*TimeStringType)(nil)
+)
+
+func (t TimeStringType) String() string {
+	return "typeutil.TimeStringType"
+}
+
+func (t TimeStringType) ValueType(ctx context.Context) attr.Value {
+	return TimeString{}
+}
+
+func (t TimeStringType) Equal(o attr.Type) bool {
+	other, ok := o.(TimeStringType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t TimeStringType) Validate(ctx context.Context, in tftypes.Value, path path.Path) (diags diag.Diagnostics) {
+	if in.Type() == nil {
+		return diags
+	}
+
+	if !in.Type().Is(tftypes.String) {
+		msg := fmt.Sprintf("expected String value, but got %T with value: %v", in, in)
+		diags.AddAttributeError(
+			path,
+			"Time String Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. "+
+				"Please report the following to the provider developer:\n\n"+msg,
+		)
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var valueString string
+	if err := in.As(&valueString); err != nil {
+		diags.AddAttributeError(
+			path,
+			"Time String Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. "+
+				"Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return diags
+	}
+
+	if _, err := parseTimeString(valueString); err != nil {
+		diags.AddAttributeError(
+			path,
+			"Invalid Time String Value",
+			"A string value was provided that is neither RFC3339 nor epoch seconds.\n\n"+
+				"Given value: "+valueString+"\n"+
+				err.Error(),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+func (t TimeStringType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return TimeString{StringValue: in}, nil
+}
+
+func (t TimeStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return TimeString{StringValue: stringValue}, nil
+}
+
+var (
+	_ basetypes.StringValuable                   = (*TimeString)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*TimeString)(nil)
+)
+
+func NewTimeStringNull() TimeString {
+	return TimeString{StringValue: basetypes.NewStringNull()}
+}
+
+func NewTimeStringUnknown() TimeString {
+	return TimeString{StringValue: basetypes.NewStringUnknown()}
+}
+
+func NewTimeStringValue(value string) TimeString {
+	return TimeString{StringValue: basetypes.NewStringValue(value)}
+}
+
+// Creates a value in epoch seconds.
+func NewTimeStringEpochValue(epoch int64) TimeString {
+	return TimeString{StringValue: basetypes.NewStringValue(strconv.FormatInt(epoch, 10))}
+}
+
+func (v TimeString) Type(_ context.Context) attr.Type {
+	return TimeStringType{}
+}
+
+// Returns the epoch seconds of the value. 0 is returned for null, unknown or invalid values.
+func (v TimeString) ValueEpoch() int64 {
+	if v.IsUnknown() || v.IsNull() {
+		return 0
+	}
+	epoch, err := parseTimeString(v.ValueString())
+	if err != nil {
+		return 0
+	}
+	return epoch
+}
+
+func (v TimeString) Equal(o attr.Value) bool {
+	other, ok := o.(TimeString)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v TimeString) StringSemanticEquals(ctx context.Context, otherValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	otherValue, ok := otherValuable.(TimeString)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got: "+fmt.Sprintf("%T", otherValuable),
+		)
+		return false, diags
+	}
+
+	epoch, err := parseTimeString(v.ValueString())
+	if err != nil {
+		return false, nil
+	}
+	otherEpoch, err := parseTimeString(otherValue.ValueString())
+	if err != nil {
+		return false, nil
+	}
+	return epoch == otherEpoch, nil
+}
+
+func parseTimeString(s string) (int64, error) {
+	if epoch, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return epoch, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return 0, err
+	}
+	return t.Unix(), nil
+}
@@ -0,0 +1,143 @@
+package typeutil_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/mackerelio-labs/terraform-provider-mackerel/internal/typeutil"
+)
+
+func Test_TimeStringType_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		in      tftypes.Value
+		wantErr bool
+	}{
+		"null": {
+			in: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			in: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"epoch": {
+			in: tftypes.NewValue(tftypes.String, "1700000000"),
+		},
+		"rfc3339": {
+			in: tftypes.NewValue(tftypes.String, "2023-11-14T22:13:20Z"),
+		},
+		"empty string": {
+			in:      tftypes.NewValue(tftypes.String, ""),
+			wantErr: true,
+		},
+		"date only": {
+			in:      tftypes.NewValue(tftypes.String, "2023-11-14"),
+			wantErr: true,
+		},
+		"wrong type": {
+			in:      tftypes.NewValue(tftypes.Number, 1700000000),
+			wantErr: true,
+		},
+	}
+
+	ctx := context.Background()
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := typeutil.TimeStringType{}.Validate(ctx, tt.in, path.Root("test"))
+
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("unexpected diags: %+v", diags)
+			}
+		})
+	}
+}
+
+func Test_TimeString_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		in        typeutil.TimeString
+		inOther   basetypes.StringValuable
+		wantMatch bool
+		wantErr   bool
+	}{
+		"strong equal": {
+			in:        typeutil.NewTimeStringValue("1700000000"),
+			inOther:   typeutil.NewTimeStringValue("1700000000"),
+			wantMatch: true,
+		},
+		"rfc3339 and epoch": {
+			in:        typeutil.NewTimeStringValue("2023-11-15T07:13:20+09:00"),
+			inOther:   typeutil.NewTimeStringEpochValue(1700000000),
+			wantMatch: true,
+		},
+		"different": {
+			in:      typeutil.NewTimeStringValue("2023-11-14T22:13:20Z"),
+			inOther: typeutil.NewTimeStringEpochValue(1700000001),
+		},
+		"wrong type": {
+			in:      typeutil.NewTimeStringValue("1700000000"),
+			inOther: basetypes.NewStringValue("1700000000"),
+			wantErr: true,
+		},
+	}
+
+	ctx := context.Background()
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := tt.in.StringSemanticEquals(ctx, tt.inOther)
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("unexpected diags: %+v", diags)
+			}
+			if diags.HasError() {
+				return
+			}
+			if match != tt.wantMatch {
+				t.Error("unexpected matching result")
+			}
+		})
+	}
+}
+
+func Test_TimeString_ValueEpoch(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		in    typeutil.TimeString
+		wants int64
+	}{
+		"epoch": {
+			in:    typeutil.NewTimeStringValue("1700000000"),
+			wants: 1700000000,
+		},
+		"rfc3339": {
+			in:    typeutil.NewTimeStringValue("2023-11-14T22:13:20Z"),
+			wants: 1700000000,
+		},
+		"null": {
+			in:    typeutil.NewTimeStringNull(),
+			wants: 0,
+		},
+		"invalid": {
+			in:    typeutil.NewTimeStringValue("invalid"),
+			wants: 0,
+		},
+	}
+
+	for name, tt := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.in.ValueEpoch(); got != tt.wants {
+				t.Errorf("expected %d, but got %d", tt.wants, got)
+			}
+		})
+	}
+}
@@ -13,6 +13,9 @@ const (
 	providerAddr = "registry.terraform.io/mackerelio-labs/mackerel"
 )
 
+// It is set by goreleaser.
+var version = "dev"
+
 func main() {
 	// No timestamp to logs
 	// FYI: https://developer.hashicorp.com/terraform/plugin/log/writing#duplicate-timestamp-and-incorrect-level-messages
@@ -30,7 +33,7 @@ func main() {
 
 	if err := tf6server.Serve(
 		providerAddr,
-		providerserver.NewProtocol6(provider.New()),
+		providerserver.NewProtocol6(provider.New(version)),
 		serveOpts...,
 	); err != nil {
 		log.Printf("[ERROR] failed to start server: %v", err)